@@ -1,8 +1,12 @@
 package app
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -14,32 +18,102 @@ import (
 )
 
 // App represents the main application
+//
+// Events, ticks, callbacks and rendering all run on the goroutine that
+// called Run, so widgets need no locking as long as only that goroutine
+// touches them. Other goroutines must hand state changes to it with Do;
+// RequestRender and Do are the only methods safe to call concurrently.
 type App struct {
 	terminal     *terminal.Terminal
 	screen       *screen.Screen
+	output       io.Writer
 	inputReader  *input.Reader
 	root         widget.Widget
+	overlays     []widget.Widget
+	mouse        bool
+	dimOnBlur    bool           // Dim the UI while the terminal is unfocused
+	title        string         // Window title, "" to leave it alone
+	debugKey     *input.Binding // Key bound by SetDebugKey
+	debugDir     string
+	focusRing    bool // Mark the focused widget's corners
+	ringStyle    terminal.Style
+	inline       int           // Rows drawn below the cursor instead of on the alternate screen, 0 for full screen
+	mouseCapture widget.Widget // Receives mouse events until the button is released
+	doubleClick  time.Duration
+	lastClick    time.Time // Time of the last left press, zero after a double-click
+	lastClickX   int
+	lastClickY   int
+	keyRepeat    time.Duration  // Longest gap between key events that marks a repeat, 0 to not mark them
+	lastKey      input.KeyEvent // Last key event, with Repeat cleared
+	lastKeyTime  time.Time
 	focusManager *widget.FocusManager
 	running      bool
 	quitChan     chan struct{}
+	quitting     bool
+	quitKeys     []input.Binding
+	bindings     []keyBinding
+	commands     []widget.Command
+	onBeforeQuit func(*App) bool
+	onInputError func(error) bool
+	inputErr     error // Input error that ended Run
 	renderChan   chan struct{}
+	doMu         sync.Mutex
+	doQueue      []func() // Functions queued by Do, guarded by doMu
+	doChan       chan struct{}
 	fps          int
+	dirty        bool      // A render is pending
+	forceNext    bool      // The pending render must repaint every cell
+	lastFrame    time.Time // When the last frame was rendered
 	onInit       func(*App)
 	onQuit       func(*App)
 	onResize     func(*App, int, int)
 	onTick       func(*App, time.Time) bool
 	tickInterval time.Duration
+	tickers      []widget.Ticker
+	timers       timerHeap // Timers from Every and After, soonest first
+	lastTimerID  TimerID
+	blinkTimer   TimerID // Toggles app-blink cells, 0 when off
+	blinkHidden  bool
+	toasts       []toast
+	toastCorner  Corner
+	maxToasts    int
+	onFrame      func(FrameStats)
+	frameTimes   []time.Duration // Ring of recent frame durations
+	frameCount   int
+	now          func() time.Time
+	terminalSize func() (int, int, error)
+	resizePoll   time.Duration
+	pendingSize  [2]int // Size seen by the last resize poll, not yet applied
 }
 
+// defaultTickInterval is used when OnTick doesn't set an interval
+const defaultTickInterval = 100 * time.Millisecond
+
 // New creates a new application
 func New() *App {
-	return &App{
+	a := &App{
 		terminal:     terminal.New(),
+		output:       os.Stdout,
 		focusManager: widget.NewFocusManager(),
 		quitChan:     make(chan struct{}),
-		renderChan:   make(chan struct{}, 1),
-		fps:          60,
+		quitKeys: []input.Binding{
+			input.RuneBinding('c', input.ModCtrl),
+			input.RuneBinding('q', input.ModCtrl),
+		},
+		renderChan:  make(chan struct{}, 1),
+		doChan:      make(chan struct{}, 1),
+		fps:         60,
+		toastCorner: CornerBottomRight,
+		maxToasts:   defaultMaxToasts,
+		doubleClick: defaultDoubleClickInterval,
+		ringStyle:   terminal.DefaultStyle().WithFG(terminal.ColorCyan),
+		now:         time.Now,
 	}
+	a.terminalSize = a.terminal.Size
+	// The default bindings give way to widgets using the same keys
+	a.Bind(input.RuneBinding('?', input.ModNone), "Show keyboard shortcuts", (*App).ShowHelp)
+	a.BindFallback(input.RuneBinding('p', input.ModCtrl), "Show command palette", (*App).ShowCommandPalette)
+	return a
 }
 
 // SetRoot sets the root widget
@@ -53,12 +127,114 @@ func (a *App) Root() widget.Widget {
 	return a.root
 }
 
-// SetFPS sets the target frames per second
+// SetFocusRing sets whether corner marks are drawn around the focused
+// widget, just outside the bounds it was rendered in
+func (a *App) SetFocusRing(ring bool) *App {
+	a.focusRing = ring
+	return a
+}
+
+// SetFocusRingStyle sets the style of the focus ring
+func (a *App) SetFocusRingStyle(style terminal.Style) *App {
+	a.ringStyle = style
+	return a
+}
+
+// SetTitle sets the terminal window and tab title
+// The title from before Run is restored when it returns.
+func (a *App) SetTitle(title string) *App {
+	a.title = title
+	if a.screen != nil {
+		a.screen.WriteRaw(terminal.TitleSequence(title))
+		a.screen.Flush()
+	}
+	return a
+}
+
+// Title returns the window title set with SetTitle
+func (a *App) Title() string {
+	return a.title
+}
+
+// CopyToClipboard copies text to the system clipboard through the
+// terminal (OSC 52), sent along with the frames
+// See terminal.ClipboardSequence for the size limit.
+func (a *App) CopyToClipboard(text string) {
+	seq := terminal.ClipboardSequence(text)
+	if a.screen == nil {
+		io.WriteString(a.output, seq)
+		return
+	}
+	a.screen.WriteRaw(seq)
+	a.screen.Flush()
+}
+
+// SetBlinkInterval blinks cells with an app-blink style, hiding and
+// showing them every interval, or stops blinking for 0
+// See terminal.Style.WithAppBlink.
+func (a *App) SetBlinkInterval(interval time.Duration) *App {
+	if a.blinkTimer != 0 {
+		a.CancelTimer(a.blinkTimer)
+		a.blinkTimer = 0
+	}
+	a.setBlinkHidden(false)
+	if interval > 0 {
+		a.blinkTimer = a.Every(interval, func(a *App, _ time.Time) bool {
+			a.setBlinkHidden(!a.blinkHidden)
+			return true
+		})
+	}
+	return a
+}
+
+// setBlinkHidden sets the blink phase, passing it on to the screen
+func (a *App) setBlinkHidden(hidden bool) {
+	a.blinkHidden = hidden
+	if a.screen != nil {
+		a.screen.SetBlinkHidden(hidden)
+	}
+}
+
+// SetDimOnBlur sets whether the whole UI is drawn dimmed while the terminal
+// window is unfocused
+// It turns on the terminal's focus reporting; set it before Run.
+func (a *App) SetDimOnBlur(dim bool) *App {
+	a.dimOnBlur = dim
+	return a
+}
+
+// AccessibilityTree describes the root widget and any overlays in words,
+// one widget per line; see widget.AccessibilityTree
+func (a *App) AccessibilityTree() string {
+	tree := widget.AccessibilityTree(a.root)
+	for _, overlay := range a.overlays {
+		tree += widget.AccessibilityTree(overlay)
+	}
+	return tree
+}
+
+// SetFPS sets the maximum frames per second
+// Render requests arriving faster than this are coalesced into one frame
 func (a *App) SetFPS(fps int) *App {
 	a.fps = fps
 	return a
 }
 
+// SetInline makes Run draw into height rows starting at the cursor's line,
+// below the shell prompt, instead of taking over the alternate screen
+// The last frame is left in place on exit. Must be called before Run.
+func (a *App) SetInline(height int) *App {
+	a.inline = height
+	return a
+}
+
+// SetOutput sets where rendered frames are written (defaults to stdout)
+// Must be called before Run
+func (a *App) SetOutput(w io.Writer) *App {
+	a.output = w
+	return a
+}
+
 // OnInit sets the initialization callback
 func (a *App) OnInit(fn func(*App)) *App {
 	a.onInit = fn
@@ -85,6 +261,13 @@ func (a *App) OnTick(interval time.Duration, fn func(*App, time.Time) bool) *App
 	return a
 }
 
+// AddTicker registers a widget to be ticked on every app tick
+// The tick runs every defaultTickInterval unless OnTick sets an interval
+func (a *App) AddTicker(t widget.Ticker) *App {
+	a.tickers = append(a.tickers, t)
+	return a
+}
+
 // FocusManager returns the focus manager
 func (a *App) FocusManager() *widget.FocusManager {
 	return a.focusManager
@@ -116,9 +299,71 @@ func (a *App) Height() int {
 	return 0
 }
 
-// Quit signals the application to quit
+// SetQuitKeys sets the key combinations that quit the app
+// (Ctrl+C and Ctrl+Q by default); with none, the app only quits via Quit
+func (a *App) SetQuitKeys(keys ...input.Binding) *App {
+	a.quitKeys = keys
+	return a
+}
+
+// OnBeforeQuit sets a guard consulted before quitting
+// Returning false cancels the quit, e.g. to ask for confirmation first
+func (a *App) OnBeforeQuit(fn func(*App) bool) *App {
+	a.onBeforeQuit = fn
+	return a
+}
+
+// OnInputError sets the handler for errors reading input
+// Return true to keep running or false to quit, in which case Run returns
+// the error. Without a handler the app keeps running.
+func (a *App) OnInputError(fn func(err error) bool) *App {
+	a.onInputError = fn
+	return a
+}
+
+// handleInputError passes an input error to the handler, quitting if it
+// says to
+func (a *App) handleInputError(err error) {
+	if a.onInputError == nil || a.onInputError(err) {
+		return
+	}
+	a.inputErr = err
+	a.ForceQuit()
+}
+
+// Quit signals the application to quit, unless the OnBeforeQuit guard or
+// a widget.QuitGuard in the widget tree cancels it
 func (a *App) Quit() {
-	if a.running {
+	if a.onBeforeQuit != nil && !a.onBeforeQuit(a) {
+		return
+	}
+	if !a.guardsAllowQuit(a.root) {
+		return
+	}
+	a.ForceQuit()
+}
+
+// guardsAllowQuit asks the QuitGuards within w, parents first, stopping at
+// the first that holds the quit up
+func (a *App) guardsAllowQuit(w widget.Widget) bool {
+	if w == nil {
+		return true
+	}
+	if g, ok := w.(widget.QuitGuard); ok && !g.BeforeQuit(a.Context(), a.Quit) {
+		return false
+	}
+	for _, child := range children(w) {
+		if !a.guardsAllowQuit(child) {
+			return false
+		}
+	}
+	return true
+}
+
+// ForceQuit signals the application to quit without consulting the guard
+func (a *App) ForceQuit() {
+	if a.running && !a.quitting {
+		a.quitting = true
 		close(a.quitChan)
 	}
 }
@@ -131,8 +376,40 @@ func (a *App) RequestRender() {
 	}
 }
 
+// Do runs fn on the event loop goroutine, in call order, and then renders
+// It never blocks, so it is safe to call from any goroutine, including
+// the loop itself; functions queued before Run start once it does.
+func (a *App) Do(fn func()) {
+	a.doMu.Lock()
+	a.doQueue = append(a.doQueue, fn)
+	a.doMu.Unlock()
+	select {
+	case a.doChan <- struct{}{}:
+	default:
+	}
+}
+
+// runQueued runs the functions queued by Do
+func (a *App) runQueued() {
+	a.doMu.Lock()
+	queue := a.doQueue
+	a.doQueue = nil
+	a.doMu.Unlock()
+	for _, fn := range queue {
+		fn()
+	}
+}
+
 // Run starts the application event loop
 func (a *App) Run() error {
+	return a.RunContext(context.Background())
+}
+
+// RunContext starts the application event loop, which also ends when ctx
+// is done
+// On cancellation the terminal is restored and the context's error is
+// returned wrapped.
+func (a *App) RunContext(ctx context.Context) error {
 	// Enter raw mode
 	if err := a.terminal.EnterRawMode(); err != nil {
 		return err
@@ -140,20 +417,47 @@ func (a *App) Run() error {
 	defer a.terminal.ExitRawMode()
 
 	// Enter alternate screen
-	a.terminal.EnterAltScreen()
-	defer a.terminal.ExitAltScreen()
+	if a.inline <= 0 {
+		a.terminal.EnterAltScreen()
+		defer a.terminal.ExitAltScreen()
+	}
 
 	// Hide cursor
 	a.terminal.HideCursor()
 	defer a.terminal.ShowCursor()
 
+	if a.mouse {
+		a.terminal.EnableMouse()
+		defer a.terminal.DisableMouse()
+	}
+
+	if a.dimOnBlur {
+		a.terminal.EnableFocusReporting()
+		defer a.terminal.DisableFocusReporting()
+	}
+
 	// Create screen
 	var err error
-	a.screen, err = screen.NewScreen(a.terminal)
+	a.screen, err = screen.NewScreenWithWriter(a.terminal, a.output)
 	if err != nil {
 		return err
 	}
 
+	// Save the window title to restore it on exit, whether or not it is set
+	a.screen.WriteRaw(terminal.TitleSave)
+	defer func() {
+		a.screen.WriteRaw(terminal.TitleRestore)
+		a.screen.Flush()
+	}()
+	if a.title != "" {
+		a.screen.WriteRaw(terminal.TitleSequence(a.title))
+	}
+	if a.inline > 0 {
+		a.screen.Resize(a.screen.Width(), min(a.inline, a.screen.Height()))
+		a.screen.EnterInline()
+		defer a.screen.ExitInline()
+	}
+
 	// Setup signal handling
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGWINCH)
@@ -172,28 +476,79 @@ func (a *App) Run() error {
 		a.onInit(a)
 	}
 
-	// Initial render
-	a.render()
+	// Let the widgets set up, and tear them down however Run ends
+	root := a.root
+	a.initTree(root)
+	defer disposeTree(root)
 
-	// Calculate frame duration
-	frameDuration := time.Second / time.Duration(a.fps)
+	// Initial render, painting over whatever was below the cursor inline
+	if a.inline > 0 {
+		a.forceRender()
+	} else {
+		a.render()
+	}
 
-	// Setup tick timer if needed
-	var tickChan <-chan time.Time
-	if a.onTick != nil && a.tickInterval > 0 {
-		ticker := time.NewTicker(a.tickInterval)
-		defer ticker.Stop()
-		tickChan = ticker.C
+	// The tick timer only runs while something needs ticking, so an idle
+	// app doesn't wake up
+	var ticker *time.Ticker
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+
+	// Renders are coalesced: events, ticks and RequestRender only mark the
+	// screen dirty, and at most one frame is drawn per frame interval
+	frameTimer := time.NewTimer(0)
+	frameTimer.Stop()
+	defer frameTimer.Stop()
+	var frameChan <-chan time.Time
+
+	// One timer wakes the loop for the soonest Every or After deadline
+	timerWake := time.NewTimer(0)
+	timerWake.Stop()
+	defer timerWake.Stop()
+
+	// Optional resize polling for environments without SIGWINCH
+	var pollChan <-chan time.Time
+	if a.resizePoll > 0 {
+		poller := time.NewTicker(a.resizePoll)
+		defer poller.Stop()
+		pollChan = poller.C
 	}
 
 	// Main event loop
 	for {
+		var tickChan <-chan time.Time
+		ticker, tickChan = a.updateTicker(ticker)
+
+		var timerChan <-chan time.Time
+		if when, ok := a.nextTimer(); ok {
+			timerWake.Reset(when.Sub(a.now()))
+			timerChan = timerWake.C
+		}
+
+		if a.dirty && frameChan == nil {
+			if wait := a.frameDelay(); wait > 0 {
+				frameTimer.Reset(wait)
+				frameChan = frameTimer.C
+			} else {
+				a.renderPending()
+			}
+		}
+
 		select {
 		case <-a.quitChan:
 			if a.onQuit != nil {
 				a.onQuit(a)
 			}
-			return nil
+			return a.inputErr
+
+		case <-ctx.Done():
+			if a.onQuit != nil {
+				a.onQuit(a)
+			}
+			return fmt.Errorf("app stopped: %w", ctx.Err())
 
 		case sig := <-sigChan:
 			switch sig {
@@ -208,29 +563,99 @@ func (a *App) Run() error {
 
 		case event := <-a.inputReader.Events():
 			if a.handleEvent(event) {
-				a.render()
+				a.dirty = true
 			}
 
 		case <-a.renderChan:
-			a.render()
+			a.dirty = true
+
+		case <-a.doChan:
+			a.runQueued()
+			a.dirty = true
 
 		case t := <-tickChan:
-			if a.onTick != nil && a.onTick(a, t) {
-				a.render()
+			if a.tick(t) {
+				a.dirty = true
+			}
+
+		case <-timerChan:
+			if a.runTimers(a.now()) {
+				a.dirty = true
 			}
 
-		case <-time.After(frameDuration):
-			// Idle - no events
+		case <-frameChan:
+			frameChan = nil
+			a.renderPending()
+
+		case <-pollChan:
+			a.pollResize()
+		}
+	}
+}
+
+// needsTick returns whether anything depends on the tick timer
+func (a *App) needsTick() bool {
+	return a.onTick != nil || len(a.tickers) > 0 || len(a.toasts) > 0
+}
+
+// updateTicker starts or stops the tick timer as needed, returning it and
+// its channel (nil while stopped)
+func (a *App) updateTicker(ticker *time.Ticker) (*time.Ticker, <-chan time.Time) {
+	if !a.needsTick() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+		return nil, nil
+	}
+	if ticker == nil {
+		interval := a.tickInterval
+		if interval <= 0 {
+			interval = defaultTickInterval
+		}
+		ticker = time.NewTicker(interval)
+	}
+	return ticker, ticker.C
+}
+
+// tick expires toasts and runs the tickers and the tick callback
+// Returns true if anything changed or requested a render
+func (a *App) tick(now time.Time) bool {
+	redraw := a.expireToasts(now)
+	for _, t := range a.tickers {
+		if t.Tick(now) {
+			redraw = true
 		}
 	}
+	if a.onTick != nil && a.onTick(a, now) {
+		redraw = true
+	}
+	return redraw
 }
 
 // handleResize handles terminal resize
 func (a *App) handleResize() {
-	width, height, err := a.terminal.Size()
+	width, height, err := a.terminalSize()
 	if err != nil {
 		return
 	}
+	a.resize(width, height)
+}
+
+// screenHeight returns the rows the screen takes on a terminal height rows
+// tall, at most the inline height in inline mode
+func (a *App) screenHeight(height int) int {
+	if a.inline > 0 {
+		return min(a.inline, height)
+	}
+	return height
+}
+
+// resize resizes the screen and schedules a full repaint if the size changed
+func (a *App) resize(width, height int) {
+	height = a.screenHeight(height)
+	if width == a.screen.Width() && height == a.screen.Height() {
+		return
+	}
 
 	a.screen.Resize(width, height)
 
@@ -238,65 +663,148 @@ func (a *App) handleResize() {
 		a.onResize(a, width, height)
 	}
 
-	a.forceRender()
+	a.dirty = true
+	a.forceNext = true
+}
+
+// frameDuration returns the minimum time between frames
+func (a *App) frameDuration() time.Duration {
+	if a.fps <= 0 {
+		return 0
+	}
+	return time.Second / time.Duration(a.fps)
+}
+
+// frameDelay returns how long the next frame must wait to keep to the
+// frame rate, or 0 if it can be drawn now
+func (a *App) frameDelay() time.Duration {
+	return max(0, a.frameDuration()-a.now().Sub(a.lastFrame))
+}
+
+// renderPending draws the pending frame
+func (a *App) renderPending() {
+	force := a.forceNext
+	a.dirty = false
+	a.forceNext = false
+	a.renderFrame(force)
 }
 
 // handleEvent processes an input event
 func (a *App) handleEvent(event input.Event) bool {
-	// Handle quit keys (Ctrl+C, Ctrl+Q)
+	if errorEvent, ok := event.(input.ErrorEvent); ok {
+		a.handleInputError(errorEvent.Err)
+		return false
+	}
+
 	if keyEvent, ok := event.(input.KeyEvent); ok {
-		if keyEvent.IsCtrl() && keyEvent.Key == input.KeyRune {
-			switch keyEvent.Rune {
-			case 'c', 'q':
+		event = a.markRepeat(keyEvent)
+	}
+
+	// Handle quit keys
+	if keyEvent, ok := event.(input.KeyEvent); ok {
+		for _, binding := range a.quitKeys {
+			if binding.Matches(keyEvent) {
 				a.Quit()
-				return false
+				return true
 			}
 		}
 	}
 
-	// Pass to root widget
-	if a.root != nil {
-		return a.root.HandleEvent(event)
+	if mouseEvent, ok := event.(input.MouseEvent); ok {
+		return a.handleMouse(mouseEvent)
 	}
 
-	return false
-}
-
-// render renders the application
-func (a *App) render() {
-	if a.screen == nil || a.root == nil {
-		return
+	if focusEvent, ok := event.(input.FocusEvent); ok && a.dimOnBlur && a.screen != nil {
+		a.screen.SetDim(!focusEvent.Focused)
+		a.dirty = true
 	}
 
-	// Clear screen
-	a.screen.Clear()
+	// Overlays are modal: the top one receives all input
+	if top := a.Overlay(); top != nil {
+		widget.HandleEventCtx(top, a.Context(), event)
+		return true
+	}
 
-	// Render root widget
-	bounds := layout.NewRect(0, 0, 0, a.screen.Width(), a.screen.Height())
-	a.root.Render(a.screen.Buffer(), bounds)
+	// Global bindings, around the root widget
+	keyEvent, isKey := event.(input.KeyEvent)
+	if isKey && a.runBinding(keyEvent, true) {
+		return true
+	}
+	if a.root != nil && widget.HandleEventCtx(a.root, a.Context(), event) {
+		return true
+	}
+	return isKey && a.runBinding(keyEvent, false)
+}
 
-	// Render to terminal
-	a.screen.Render()
-	a.screen.Flush()
+// render renders the application
+func (a *App) render() {
+	a.renderFrame(false)
 }
 
 // forceRender renders the entire screen regardless of changes
 // Used after resize to clear artifacts from the previous terminal size
 func (a *App) forceRender() {
+	a.renderFrame(true)
+}
+
+// draw renders the root, overlays and toasts into buf
+func (a *App) draw(buf *screen.Buffer) {
+	bounds := layout.NewRect(0, 0, 0, buf.Width(), buf.Height())
+	widget.ResetBounds(a.root)
+	for _, w := range a.overlays {
+		widget.ResetBounds(w)
+	}
+	a.root.Render(buf, bounds)
+	a.renderOverlays(buf, bounds)
+	a.renderFocusRing(buf)
+	a.renderToasts(buf, bounds)
+}
+
+// renderFocusRing marks the focused widget of the top overlay, or of the
+// root if there is no overlay, when the focus ring is on
+func (a *App) renderFocusRing(buf *screen.Buffer) {
+	if !a.focusRing {
+		return
+	}
+	root := a.root
+	if top := a.Overlay(); top != nil {
+		root = top
+	}
+	if focused, ok := widget.FocusedWidget(root).(interface{ Bounds() layout.Rect }); ok {
+		widget.DrawFocusRing(buf, focused.Bounds(), a.ringStyle)
+	}
+}
+
+// renderFrame draws the root, overlays and toasts and writes the frame to
+// the terminal, repainting every cell if force is set
+func (a *App) renderFrame(force bool) {
 	if a.screen == nil || a.root == nil {
 		return
 	}
+	start := a.now()
+	written := a.screen.BytesWritten()
+	a.dirty = false
+	a.forceNext = false
+	a.lastFrame = start
 
-	// Clear screen
+	// Clear screen and draw
 	a.screen.Clear()
+	a.draw(a.screen.Buffer())
 
-	// Render root widget
-	bounds := layout.NewRect(0, 0, 0, a.screen.Width(), a.screen.Height())
-	a.root.Render(a.screen.Buffer(), bounds)
-
-	// Force render all cells to terminal
-	a.screen.ForceRender()
+	// Render to terminal
+	if force {
+		a.screen.ForceRender()
+	} else {
+		a.screen.Render()
+	}
 	a.screen.Flush()
+
+	a.recordFrame(FrameStats{
+		Time:         start,
+		Duration:     a.now().Sub(start),
+		Bytes:        int(a.screen.BytesWritten() - written),
+		CellsChanged: a.screen.ChangedCells(),
+	})
 }
 
 // SimpleApp provides a simpler API for basic applications
@@ -341,6 +849,10 @@ type simpleLayout struct {
 }
 
 func (l *simpleLayout) Render(buf *screen.Buffer, bounds layout.Rect) {
+	l.SetBounds(bounds)
+	if bounds.IsEmpty() {
+		return
+	}
 	style := terminal.DefaultStyle()
 	titleStyle := style.WithBold().WithReverse()
 	statusStyle := style.WithReverse()
@@ -368,19 +880,30 @@ func (l *simpleLayout) Render(buf *screen.Buffer, bounds layout.Rect) {
 			bounds.Y+1,
 			bounds.Z,
 			bounds.Width,
-			bounds.Height-2,
+			max(0, bounds.Height-2),
 		)
 		l.content.Render(buf, contentBounds)
 	}
 }
 
 func (l *simpleLayout) HandleEvent(event input.Event) bool {
+	return l.HandleEventCtx(nil, event)
+}
+
+func (l *simpleLayout) HandleEventCtx(ctx widget.EventContext, event input.Event) bool {
 	if l.content != nil {
-		return l.content.HandleEvent(event)
+		return widget.HandleEventCtx(l.content, ctx, event)
 	}
 	return false
 }
 
+func (l *simpleLayout) Children() []widget.Widget {
+	if l.content != nil {
+		return []widget.Widget{l.content}
+	}
+	return nil
+}
+
 func (l *simpleLayout) Size() layout.Size {
 	return layout.NewSize(80, 24)
 }
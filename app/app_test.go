@@ -0,0 +1,372 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/widget"
+)
+
+// newScreenApp returns an app drawing root to an in-memory screen, and the
+// frames it renders
+func newScreenApp(root widget.Widget, width, height int) (*App, *[]FrameStats) {
+	a := New().SetRoot(root)
+	a.screen = screen.NewScreenSize(width, height, &bytes.Buffer{})
+	frames := &[]FrameStats{}
+	a.OnFrame(func(stats FrameStats) { *frames = append(*frames, stats) })
+	return a, frames
+}
+
+// tickFunc adapts a function to widget.Ticker
+type tickFunc func(time.Time) bool
+
+func (f tickFunc) Tick(now time.Time) bool { return f(now) }
+
+func TestTickerRunsOnlyWhenNeeded(t *testing.T) {
+	a := New()
+	ticker, ch := a.updateTicker(nil)
+	if ticker != nil || ch != nil {
+		t.Fatal("idle app started a tick timer")
+	}
+
+	a.OnTick(time.Hour, func(*App, time.Time) bool { return false })
+	ticker, ch = a.updateTicker(nil)
+	if ticker == nil || ch == nil {
+		t.Fatal("no tick timer with a tick callback")
+	}
+	if again, _ := a.updateTicker(ticker); again != ticker {
+		t.Error("updateTicker replaced a running ticker, want it reused")
+	}
+
+	a.OnTick(0, nil)
+	if stopped, ch := a.updateTicker(ticker); stopped != nil || ch != nil {
+		t.Error("tick timer kept running with nothing to tick")
+	}
+}
+
+func TestTickRequestsRender(t *testing.T) {
+	a, frames := newScreenApp(widget.NewText("hi"), 4, 1)
+	changed := false
+	a.AddTicker(tickFunc(func(time.Time) bool { return changed }))
+
+	if a.tick(time.Unix(0, 0)) {
+		t.Error("tick() = true with nothing changed, want false")
+	}
+	changed = true
+	if !a.tick(time.Unix(0, 0)) {
+		t.Error("tick() = false after a ticker changed, want true")
+	}
+
+	a.RequestRender()
+	select {
+	case <-a.renderChan:
+	default:
+		t.Error("RequestRender did not signal the loop")
+	}
+	a.render()
+	if len(*frames) != 1 {
+		t.Errorf("got %d frames, want 1", len(*frames))
+	}
+}
+
+func TestEventsWithinFrameCoalesce(t *testing.T) {
+	now := time.Unix(0, 0)
+	field := widget.NewTextInput()
+	field.SetFocused(true)
+	a, frames := newScreenApp(field, 10, 1)
+	a.now = func() time.Time { return now }
+	a.SetFPS(50)
+	a.renderPending()
+
+	now = now.Add(5 * time.Millisecond)
+	for _, r := range "abc" {
+		a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: r})
+	}
+	a.RequestRender()
+	a.RequestRender()
+	if len(*frames) != 1 {
+		t.Fatalf("got %d frames while handling events, want none drawn directly", len(*frames)-1)
+	}
+	if !a.dirty {
+		t.Fatal("events did not mark a render pending")
+	}
+	if got := a.frameDelay(); got != 15*time.Millisecond {
+		t.Errorf("frameDelay = %v, want the rest of the 20ms frame", got)
+	}
+	if got := len(a.renderChan); got != 1 {
+		t.Errorf("%d render requests queued, want 1", got)
+	}
+
+	now = now.Add(15 * time.Millisecond)
+	if got := a.frameDelay(); got != 0 {
+		t.Errorf("frameDelay at the frame boundary = %v, want 0", got)
+	}
+	a.renderPending()
+	if len(*frames) != 2 || a.dirty {
+		t.Errorf("got %d frames, dirty %v; want one frame for the burst", len(*frames)-1, a.dirty)
+	}
+}
+
+func TestResizeRepaintsOnce(t *testing.T) {
+	a, frames := newScreenApp(widget.NewText("hi"), 10, 3)
+	a.renderPending()
+
+	a.resize(10, 3)
+	if a.forceNext {
+		t.Error("resize to the same size scheduled a full repaint")
+	}
+
+	a.resize(12, 4)
+	if !a.dirty || !a.forceNext {
+		t.Fatalf("after resize dirty = %v, forceNext = %v; want both true", a.dirty, a.forceNext)
+	}
+	a.renderPending()
+	a.renderPending()
+	if len(*frames) != 3 {
+		t.Fatalf("got %d frames, want 3", len(*frames))
+	}
+	if got := (*frames)[1].CellsChanged; got != 12*4 {
+		t.Errorf("repaint after resize changed %d cells, want %d", got, 12*4)
+	}
+	if got := (*frames)[2].CellsChanged; got != 0 {
+		t.Errorf("next frame changed %d cells, want 0", got)
+	}
+}
+
+// quit reports whether a has been told to quit
+func quit(a *App) bool {
+	select {
+	case <-a.quitChan:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestQuitGuard(t *testing.T) {
+	a := New()
+	a.running = true
+	allow, asked := false, 0
+	a.OnBeforeQuit(func(*App) bool {
+		asked++
+		return allow
+	})
+	a.SetQuitKeys(input.RuneBinding('x', input.ModCtrl))
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'c', Modifier: input.ModCtrl})
+	if asked != 0 {
+		t.Error("Ctrl+C quit after other quit keys were set")
+	}
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'x', Modifier: input.ModCtrl})
+	a.Quit()
+	if asked != 2 || quit(a) {
+		t.Fatalf("guard asked %d times, quit %v; want 2 and still running", asked, quit(a))
+	}
+
+	a.ForceQuit()
+	if !quit(a) {
+		t.Fatal("ForceQuit did not quit")
+	}
+	a.ForceQuit() // Closing twice would panic
+}
+
+func TestQuitGuardAllows(t *testing.T) {
+	a := New()
+	a.running = true
+	a.OnBeforeQuit(func(*App) bool { return true })
+	a.Quit()
+	if !quit(a) {
+		t.Error("Quit did not quit when the guard allowed it")
+	}
+}
+
+func TestInputErrorHandler(t *testing.T) {
+	a := New()
+	a.running = true
+	var got []error
+	keepRunning := true
+	a.OnInputError(func(err error) bool {
+		got = append(got, err)
+		return keepRunning
+	})
+
+	err := errors.New("read failed")
+	a.handleEvent(input.ErrorEvent{Err: err})
+	if len(got) != 1 || got[0] != err {
+		t.Fatalf("handler got %v, want [%v]", got, err)
+	}
+	if quit(a) {
+		t.Fatal("quit although the handler returned true")
+	}
+
+	keepRunning = false
+	a.handleEvent(input.ErrorEvent{Err: err})
+	if !quit(a) {
+		t.Fatal("not quit although the handler returned false")
+	}
+	if a.inputErr != err {
+		t.Errorf("inputErr = %v, want %v", a.inputErr, err)
+	}
+}
+
+func TestInlineResizeKeepsHeight(t *testing.T) {
+	a, _ := newScreenApp(widget.NewText("hi"), 10, 3)
+	a.SetInline(3)
+	a.resize(20, 40)
+	if a.screen.Width() != 20 || a.screen.Height() != 3 {
+		t.Errorf("screen size = %dx%d, want 20x3", a.screen.Width(), a.screen.Height())
+	}
+	a.resize(20, 2)
+	if a.screen.Height() != 2 {
+		t.Errorf("screen height = %d, want 2 on a shorter terminal", a.screen.Height())
+	}
+}
+
+func TestAccessibilityTreeIncludesOverlays(t *testing.T) {
+	form := widget.NewForm().SetTitle("Login")
+	form.AddTextInput("User", "").SetValue("ann")
+	a := New().SetRoot(form)
+	a.PushOverlay(widget.NewButton("Close"))
+
+	want := "Form 'Login'\n" +
+		"Form 'Login' > TextInput 'User' value='ann' [focused]\n" +
+		"Button 'Close' [focused]\n"
+	if got := a.AccessibilityTree(); got != want {
+		t.Errorf("AccessibilityTree() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDimOnBlur(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		a, _ := newScreenApp(widget.NewText("hi"), 4, 1)
+		a.SetDimOnBlur(enabled)
+
+		a.handleEvent(input.FocusEvent{Focused: false})
+		if a.screen.Dim() != enabled {
+			t.Errorf("SetDimOnBlur(%v): Dim() after blur = %v, want %v", enabled, a.screen.Dim(), enabled)
+		}
+		a.handleEvent(input.FocusEvent{Focused: true})
+		if a.screen.Dim() {
+			t.Errorf("SetDimOnBlur(%v): Dim() after focus = true, want false", enabled)
+		}
+	}
+}
+
+func TestUnsavedGuardCleanQuits(t *testing.T) {
+	a := New().SetRoot(widget.NewUnsavedGuard(widget.NewText("doc")))
+	a.running = true
+	a.Quit()
+	if !quit(a) || a.Overlay() != nil {
+		t.Errorf("clean guard: quit %v, overlay %v; want quit and no dialog", quit(a), a.Overlay())
+	}
+}
+
+func TestUnsavedGuardDirtyAsks(t *testing.T) {
+	guard := widget.NewUnsavedGuard(widget.NewText("doc")).SetDirty(true)
+	a := New().SetRoot(widget.NewSplitPane(layout.Horizontal, widget.NewText("side"), guard))
+	a.running = true
+
+	a.Quit()
+	dialog := a.Overlay()
+	if quit(a) || dialog == nil {
+		t.Fatalf("dirty guard: quit %v, overlay %v; want a dialog and still running", quit(a), dialog)
+	}
+	a.Quit()
+	if a.Overlay() != dialog || len(a.overlays) != 1 {
+		t.Errorf("second Quit showed another dialog, %d overlays", len(a.overlays))
+	}
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyEscape})
+	if quit(a) || a.Overlay() != nil {
+		t.Fatalf("after Escape: quit %v, overlay %v; want still running with no dialog", quit(a), a.Overlay())
+	}
+
+	a.Quit()
+	a.Dispatch(input.KeyEvent{Key: input.KeyEnter})
+	if !quit(a) {
+		t.Error("confirming the dialog did not quit")
+	}
+	if !guard.IsDirty() {
+		t.Error("confirming cleared the dirty flag")
+	}
+}
+
+func TestFocusRing(t *testing.T) {
+	form := widget.NewForm().SetShowBorder(true)
+	field := form.AddTextInput("Name", "")
+	form.SetFocused(true)
+	a := New().SetRoot(form)
+	buf := screen.NewBuffer(30, 8, screen.DefaultDepth)
+
+	a.draw(buf)
+	b := field.Bounds()
+	if got := buf.Get(b.X-1, b.Y-1, 0).Rune; got == '┌' {
+		t.Fatal("focus ring drawn while off")
+	}
+
+	a.SetFocusRing(true)
+	buf.Clear()
+	a.draw(buf)
+	corners := []struct {
+		x, y int
+		want rune
+	}{
+		{b.X - 1, b.Y - 1, '┌'},
+		{b.Right(), b.Y - 1, '┐'},
+		{b.X - 1, b.Bottom(), '└'},
+		{b.Right(), b.Bottom(), '┘'},
+	}
+	for _, c := range corners {
+		if got := buf.Get(c.x, c.y, 0).Rune; got != c.want {
+			t.Errorf("cell (%d, %d) = %q, want %q", c.x, c.y, got, c.want)
+		}
+	}
+}
+
+func TestBlinkInterval(t *testing.T) {
+	now := time.Unix(0, 0)
+	a, _ := newScreenApp(widget.NewText("hi").SetStyle(terminal.DefaultStyle().WithAppBlink()), 2, 1)
+	a.now = func() time.Time { return now }
+	a.SetBlinkInterval(500 * time.Millisecond)
+	out := a.screen.Writer().(*bytes.Buffer)
+
+	for i, hidden := range []bool{true, false, true} {
+		now = now.Add(500 * time.Millisecond)
+		if !a.runTimers(now) {
+			t.Fatalf("phase %d: blink did not request a render", i)
+		}
+		out.Reset()
+		a.renderPending()
+		if shown := strings.Contains(out.String(), "hi"); a.blinkHidden != hidden || shown == hidden {
+			t.Errorf("phase %d: hidden = %v, drew %q; want hidden %v", i, a.blinkHidden, out.String(), hidden)
+		}
+	}
+
+	a.SetBlinkInterval(0)
+	if a.blinkHidden || len(a.timers) != 0 {
+		t.Errorf("after SetBlinkInterval(0): hidden = %v, %d timers; want shown and none", a.blinkHidden, len(a.timers))
+	}
+}
+
+func TestCopyToClipboard(t *testing.T) {
+	var out bytes.Buffer
+	a := New().SetOutput(&out)
+	a.CopyToClipboard("hi")
+	if want := terminal.ClipboardSequence("hi"); out.String() != want {
+		t.Errorf("before Run wrote %q, want %q", out.String(), want)
+	}
+
+	a, _ = newScreenApp(widget.NewText(""), 2, 1)
+	screenOut := a.screen.Writer().(*bytes.Buffer)
+	a.CopyToClipboard("hi")
+	if want := terminal.ClipboardSequence("hi"); !strings.Contains(screenOut.String(), want) {
+		t.Errorf("screen output %q does not contain %q", screenOut.String(), want)
+	}
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/widget"
+)
+
+// keyBinding is a global key binding registered with Bind
+type keyBinding struct {
+	key         input.Binding
+	description string
+	action      func(*App)
+	fallback    bool // Runs only when the UI doesn't handle the key
+}
+
+// Bind registers a global key binding, replacing any action already bound
+// to key
+// Special keys and keys with a modifier run before the UI sees them, while
+// plain characters run only when the UI doesn't handle them, so typing into
+// an input isn't hijacked. Bindings don't run while an overlay is shown.
+func (a *App) Bind(key input.Binding, description string, action func(*App)) *App {
+	a.Unbind(key)
+	a.bindings = append(a.bindings, keyBinding{key: key, description: description, action: action})
+	return a
+}
+
+// BindFallback is like Bind, but the binding runs only when the UI
+// doesn't handle the key, even for special keys and keys with a modifier
+func (a *App) BindFallback(key input.Binding, description string, action func(*App)) *App {
+	a.Bind(key, description, action)
+	a.bindings[len(a.bindings)-1].fallback = true
+	return a
+}
+
+// Unbind removes the binding for key
+func (a *App) Unbind(key input.Binding) *App {
+	for i, b := range a.bindings {
+		if b.key == key {
+			a.bindings = append(a.bindings[:i], a.bindings[i+1:]...)
+			break
+		}
+	}
+	return a
+}
+
+// Bindings returns help entries for the quit keys and the global bindings
+func (a *App) Bindings() []widget.HelpEntry {
+	entries := make([]widget.HelpEntry, 0, len(a.quitKeys)+len(a.bindings))
+	for _, key := range a.quitKeys {
+		entries = append(entries, widget.HelpEntry{Keys: key.String(), Description: "Quit"})
+	}
+	for _, b := range a.bindings {
+		entries = append(entries, widget.HelpEntry{Keys: b.key.String(), Description: b.description})
+	}
+	return entries
+}
+
+// runBinding runs the binding matching e, if any, considering only the
+// bindings that run before the UI if early is set and only the rest if not
+// Returns true if a binding ran
+func (a *App) runBinding(e input.KeyEvent, early bool) bool {
+	for _, b := range a.bindings {
+		late := b.fallback || b.key.Key == input.KeyRune && b.key.Modifier == input.ModNone
+		if late == early || !b.key.Matches(e) {
+			continue
+		}
+		if b.action != nil {
+			b.action(a)
+		}
+		return true
+	}
+	return false
+}
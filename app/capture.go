@@ -0,0 +1,76 @@
+package app
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/screen"
+)
+
+// Capture returns the last frame drawn to the screen as plain text, e.g.
+// for bug reports
+// Before the first frame the UI is rendered into a scratch buffer instead.
+// The live screen is not touched.
+func (a *App) Capture() string {
+	buf := a.captureBuffer()
+	if buf == nil {
+		return ""
+	}
+	return buf.ToString()
+}
+
+// CaptureANSI is like Capture but keeps colors and attributes as ANSI
+// escape sequences
+func (a *App) CaptureANSI() string {
+	buf := a.captureBuffer()
+	if buf == nil {
+		return ""
+	}
+	return buf.ToStyledString()
+}
+
+// captureBuffer returns a copy of the last frame, or before the first frame
+// renders the UI into a new buffer the size of the terminal, falling back
+// to the root's preferred size
+// Copying the frame leaves widget state such as bounds and scroll offsets
+// as the live screen last drew it.
+func (a *App) captureBuffer() *screen.Buffer {
+	if a.root == nil {
+		return nil
+	}
+	if a.screen != nil && !a.lastFrame.IsZero() {
+		return a.screen.Buffer().Clone()
+	}
+
+	var width, height int
+	depth := screen.DefaultDepth
+	if a.screen != nil {
+		width, height, depth = a.screen.Width(), a.screen.Height(), a.screen.Depth()
+	} else if w, h, err := a.terminal.Size(); err == nil {
+		width, height = w, h
+	} else {
+		size := a.root.Size()
+		width, height = size.Width, size.Height
+	}
+
+	buf := screen.NewBuffer(width, height, depth)
+	a.draw(buf)
+	return buf
+}
+
+// RenderTo draws the UI into buf as a frame would be drawn to the screen,
+// e.g. to drive the app without a terminal
+func (a *App) RenderTo(buf *screen.Buffer) {
+	if a.root == nil {
+		return
+	}
+	a.draw(buf)
+}
+
+// Dispatch handles event as if it had been read from the terminal, then
+// runs any functions queued with Do
+// Mouse events are matched against the bounds of the last render.
+func (a *App) Dispatch(event input.Event) {
+	if a.handleEvent(event) {
+		a.dirty = true
+	}
+	a.runQueued()
+}
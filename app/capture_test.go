@@ -0,0 +1,46 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/widget"
+)
+
+func TestCaptureBeforeFirstFrame(t *testing.T) {
+	a, _ := newScreenApp(widget.NewText("hello"), 7, 3)
+	a.PushOverlay(widget.NewText("ab"))
+
+	want := "hello\n  ab\n"
+	if got := a.Capture(); got != want {
+		t.Errorf("Capture() = %q, want %q", got, want)
+	}
+	if got := a.screen.Buffer().ToString(); strings.TrimSpace(got) != "" {
+		t.Errorf("screen buffer = %q, want it left untouched", got)
+	}
+}
+
+func TestCaptureReturnsLastFrame(t *testing.T) {
+	text := widget.NewText("one")
+	a, _ := newScreenApp(text, 5, 1)
+	a.renderPending()
+	text.SetText("two")
+
+	if got := a.Capture(); got != "one" {
+		t.Errorf("Capture() = %q, want the last frame %q", got, "one")
+	}
+}
+
+func TestCaptureANSIKeepsStyles(t *testing.T) {
+	style := terminal.DefaultStyle().WithFG(terminal.ColorRed)
+	a, _ := newScreenApp(widget.NewText("hi").SetStyle(style), 2, 1)
+
+	got := a.CaptureANSI()
+	if !strings.Contains(got, style.Sequence()+"hi") {
+		t.Errorf("CaptureANSI() = %q, want %q styled red", got, "hi")
+	}
+	if New().Capture() != "" {
+		t.Error("Capture() without a root is not empty")
+	}
+}
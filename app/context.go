@@ -0,0 +1,42 @@
+package app
+
+import (
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/widget"
+)
+
+// appContext is the widget.EventContext of an App
+type appContext struct {
+	app *App
+}
+
+// Context returns the context passed to widgets implementing
+// widget.ContextHandler
+func (a *App) Context() widget.EventContext {
+	return appContext{app: a}
+}
+
+// RequestRender asks for the screen to be redrawn
+func (c appContext) RequestRender() {
+	c.app.RequestRender()
+}
+
+// PushOverlay shows w as a modal overlay
+func (c appContext) PushOverlay(w widget.Widget) {
+	c.app.PushOverlay(w)
+}
+
+// PopOverlay removes and returns the top overlay
+func (c appContext) PopOverlay() widget.Widget {
+	return c.app.PopOverlay()
+}
+
+// Confirm shows a modal yes/no question
+func (c appContext) Confirm(message string, onDone func(ok bool)) {
+	c.app.Confirm(message, onDone)
+}
+
+// Size returns the size of the screen
+func (c appContext) Size() layout.Size {
+	return layout.NewSize(c.app.Width(), c.app.Height())
+}
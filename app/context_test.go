@@ -0,0 +1,69 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// ctxWidget requests a render and shows an overlay through the context
+// it is given, without reporting events as handled
+type ctxWidget struct {
+	widget.BaseWidget
+	ctx widget.EventContext
+}
+
+func newCtxWidget() *ctxWidget {
+	w := &ctxWidget{BaseWidget: widget.NewBaseWidget()}
+	w.SetInteractive(true)
+	return w
+}
+
+func (w *ctxWidget) Render(buf *screen.Buffer, bounds layout.Rect) {}
+func (w *ctxWidget) HandleEvent(event input.Event) bool            { return false }
+func (w *ctxWidget) Size() layout.Size                             { return layout.NewSize(5, 1) }
+func (w *ctxWidget) MinSize() layout.Size                          { return layout.NewSize(1, 1) }
+
+func (w *ctxWidget) HandleEventCtx(ctx widget.EventContext, event input.Event) bool {
+	w.ctx = ctx
+	ctx.RequestRender()
+	return false
+}
+
+func TestContextRequestRender(t *testing.T) {
+	w := newCtxWidget()
+	a := New().SetRoot(w)
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'x'})
+	if w.ctx == nil {
+		t.Fatal("HandleEventCtx not called")
+	}
+	select {
+	case <-a.renderChan:
+	default:
+		t.Error("RequestRender through the context did not request a render")
+	}
+}
+
+func TestContextPassedThroughContainers(t *testing.T) {
+	w := newCtxWidget()
+	form := widget.NewForm().AddField("Field", w)
+	a := New().SetRoot(form)
+	a.FocusManager().Add(form)
+	a.FocusManager().Focus(form)
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'x'})
+	if w.ctx == nil {
+		t.Fatal("context not passed through the form")
+	}
+
+	overlay := widget.NewText("modal")
+	w.ctx.PushOverlay(overlay)
+	if a.Overlay() != overlay {
+		t.Error("PushOverlay through the context did not show the overlay")
+	}
+	if got := w.ctx.PopOverlay(); got != overlay {
+		t.Errorf("PopOverlay() = %v, want the overlay", got)
+	}
+}
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/widget"
+)
+
+// debugNoticeDuration is how long the notice about a debug snapshot shows
+const debugNoticeDuration = 5 * time.Second
+
+// SetDebugKey binds key to WriteDebugSnapshot, for users to attach the
+// snapshot to bug reports, replacing any key bound by an earlier call
+func (a *App) SetDebugKey(key input.Binding) *App {
+	if a.debugKey != nil {
+		a.Unbind(*a.debugKey)
+	}
+	a.debugKey = &key
+	return a.Bind(key, "Save debug snapshot", func(a *App) {
+		if path, err := a.WriteDebugSnapshot(); err != nil {
+			a.Notify("Debug snapshot failed: "+err.Error(), debugNoticeDuration)
+		} else {
+			a.Notify("Debug snapshot saved to "+path, debugNoticeDuration)
+		}
+	})
+}
+
+// SetDebugDir sets the directory debug snapshots are written to, the
+// system temporary directory by default
+func (a *App) SetDebugDir(dir string) *App {
+	a.debugDir = dir
+	return a
+}
+
+// DebugSnapshot returns the screen with its colors, the accessibility tree
+// and the focus path, under headings
+func (a *App) DebugSnapshot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "== gotui debug snapshot %s ==\n", a.now().Format(time.RFC3339))
+	b.WriteString("\n== Screen ==\n")
+	b.WriteString(a.CaptureANSI())
+	b.WriteString("\n\n== Accessibility tree ==\n")
+	b.WriteString(a.AccessibilityTree())
+	b.WriteString("\n== Focus path ==\n")
+	root := a.root
+	if top := a.Overlay(); top != nil {
+		root = top
+	}
+	b.WriteString(strings.Join(focusPath(root), " > "))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// WriteDebugSnapshot writes DebugSnapshot to a new file in the debug
+// directory and returns its path
+func (a *App) WriteDebugSnapshot() (string, error) {
+	dir := a.debugDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	name := "gotui-debug-" + a.now().Format("20060102-150405.000") + ".txt"
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(a.DebugSnapshot()), 0o600); err != nil {
+		return "", fmt.Errorf("writing debug snapshot: %w", err)
+	}
+	return path, nil
+}
+
+// focusPath describes the focused widgets from w down
+func focusPath(w widget.Widget) []string {
+	var path []string
+	for w != nil && w.IsFocused() {
+		path = append(path, widget.Describe(w))
+		var next widget.Widget
+		for _, child := range children(w) {
+			if child.IsFocused() {
+				next = child
+				break
+			}
+		}
+		w = next
+	}
+	return path
+}
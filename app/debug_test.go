@@ -0,0 +1,67 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/widget"
+)
+
+func TestDebugKeyWritesSnapshot(t *testing.T) {
+	form := widget.NewForm().SetTitle("Login")
+	form.AddTextInput("User", "").SetValue("ann")
+	form.SetFocused(true)
+	dir := t.TempDir()
+	a := New().SetRoot(form).SetDebugDir(dir).SetDebugKey(input.RuneBinding('d', input.ModCtrl))
+	a.now = func() time.Time { return time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC) }
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'd', Modifier: input.ModCtrl})
+
+	path := filepath.Join(dir, "gotui-debug-20240501-123000.000.txt")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("snapshot not written: %v", err)
+	}
+	snapshot := string(data)
+	for _, want := range []string{
+		"== gotui debug snapshot 2024-05-01T12:30:00Z ==",
+		"== Screen ==",
+		"== Accessibility tree ==\nForm 'Login'\nForm 'Login' > TextInput 'User' value='ann' [focused]\n",
+		"== Focus path ==\nForm 'Login' > TextInput value='ann' [focused]\n",
+	} {
+		if !strings.Contains(snapshot, want) {
+			t.Errorf("snapshot does not contain %q:\n%s", want, snapshot)
+		}
+	}
+	if len(a.toasts) != 1 || a.toasts[0].text != "Debug snapshot saved to "+path {
+		t.Errorf("toasts = %v, want one naming %s", a.toasts, path)
+	}
+}
+
+func TestDebugSnapshotError(t *testing.T) {
+	a := New().SetRoot(widget.NewText("hi")).SetDebugDir(filepath.Join(t.TempDir(), "missing"))
+	a.SetDebugKey(input.RuneBinding('d', input.ModCtrl))
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'd', Modifier: input.ModCtrl})
+	if len(a.toasts) != 1 || !strings.HasPrefix(a.toasts[0].text, "Debug snapshot failed: ") {
+		t.Errorf("toasts = %v, want one reporting the failure", a.toasts)
+	}
+}
+
+func TestSetDebugKeyReplaces(t *testing.T) {
+	dir := t.TempDir()
+	a := New().SetRoot(widget.NewText("hi")).SetDebugDir(dir)
+	a.SetDebugKey(input.RuneBinding('d', input.ModCtrl)).SetDebugKey(input.RuneBinding('g', input.ModCtrl))
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'd', Modifier: input.ModCtrl})
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Error("the replaced debug key still wrote a snapshot")
+	}
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'g', Modifier: input.ModCtrl})
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("new debug key wrote %d snapshots, want 1", len(entries))
+	}
+}
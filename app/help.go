@@ -0,0 +1,132 @@
+package app
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/widget"
+)
+
+// helpTitle is the title of the help overlay
+const helpTitle = "Keyboard shortcuts"
+
+// helpOverlay is a modal box listing keys and what they do in two columns,
+// scrolled with the arrow keys
+// Escape, Enter, q or ? closes it
+type helpOverlay struct {
+	widget.BaseWidget
+	table   *widget.Table
+	rows    int
+	width   int // Width of the key and description columns together
+	style   terminal.Style
+	onClose func()
+}
+
+// newHelpOverlay creates a help overlay listing the groups of entries,
+// separated by blank lines
+func newHelpOverlay(groups [][]widget.HelpEntry, onClose func()) *helpOverlay {
+	style := terminal.DefaultStyle()
+	keyWidth, descWidth := 0, 0
+	var rows [][]string
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if len(rows) > 0 {
+			rows = append(rows, []string{"", ""})
+		}
+		for _, entry := range group {
+			keyWidth = max(keyWidth, screen.DisplayWidth(entry.Keys))
+			descWidth = max(descWidth, screen.DisplayWidth(entry.Description))
+			rows = append(rows, []string{entry.Keys, entry.Description})
+		}
+	}
+
+	table := widget.NewTable().
+		SetColumns([]widget.TableColumn{
+			{Title: "", Width: keyWidth + 2},
+			{Title: "", Width: descWidth},
+		}).
+		SetRows(rows).
+		SetShowHeader(false).
+		SetShowBorder(false).
+		SetColumnBorders(false).
+		SetRowBorders(false).
+		SetStyle(style).
+		SetHeaderStyle(style).
+		SetSelectedStyle(style)
+
+	h := &helpOverlay{
+		BaseWidget: widget.NewBaseWidget(),
+		table:      table,
+		rows:       len(rows),
+		width:      keyWidth + 2 + descWidth,
+		style:      style,
+		onClose:    onClose,
+	}
+	h.SetInteractive(true)
+	return h
+}
+
+// SetFocused sets focus state, passing it on to the table so it scrolls
+func (h *helpOverlay) SetFocused(focused bool) {
+	h.BaseWidget.SetFocused(focused)
+	h.table.SetFocused(focused)
+}
+
+// Children returns the table
+func (h *helpOverlay) Children() []widget.Widget {
+	return []widget.Widget{h.table}
+}
+
+// Render draws the help box
+func (h *helpOverlay) Render(buf *screen.Buffer, bounds layout.Rect) {
+	h.SetBounds(bounds)
+	if !h.IsVisible() || bounds.Width < 4 || bounds.Height < 3 {
+		return
+	}
+
+	buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, h.style)
+	title := screen.Truncate(" "+helpTitle+" ", bounds.Width-4, true)
+	buf.DrawString(bounds.X+2, bounds.Y, bounds.Z, title, h.style.WithBold())
+	h.table.Render(buf, bounds.Inset(1, 2, 1, 2))
+}
+
+// HandleEvent handles input events
+func (h *helpOverlay) HandleEvent(event input.Event) bool {
+	if keyEvent, ok := event.(input.KeyEvent); ok {
+		switch {
+		case keyEvent.Key == input.KeyEscape, keyEvent.Key == input.KeyEnter,
+			keyEvent.Key == input.KeyRune && (keyEvent.Rune == 'q' || keyEvent.Rune == '?'):
+			if h.onClose != nil {
+				h.onClose()
+			}
+			return true
+		}
+	}
+	return h.table.HandleEvent(event)
+}
+
+// Size returns the preferred size
+func (h *helpOverlay) Size() layout.Size {
+	width := max(h.width, screen.DisplayWidth(helpTitle)+2) + 4
+	return layout.NewSize(width, h.rows+2)
+}
+
+// MinSize returns the minimum size
+func (h *helpOverlay) MinSize() layout.Size {
+	return layout.NewSize(screen.DisplayWidth(helpTitle)+6, 3)
+}
+
+// ShowHelp shows an overlay listing the global key bindings, followed by
+// the keys of the focused widgets that implement widget.HelpProvider
+func (a *App) ShowHelp() {
+	var h *helpOverlay
+	h = newHelpOverlay([][]widget.HelpEntry{a.Bindings(), widget.FocusedHelp(a.root)}, func() {
+		if a.Overlay() == h {
+			a.PopOverlay()
+		}
+	})
+	a.PushOverlay(h)
+}
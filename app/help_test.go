@@ -0,0 +1,55 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+func TestHelpListsBindingsAndWidgetKeys(t *testing.T) {
+	list := widget.NewList().SetStrings([]string{"one"})
+	list.SetFocused(true)
+	a := New().SetRoot(list)
+	refresh := input.NewBinding(input.KeyF5, input.ModNone)
+	a.Bind(refresh, "Refresh", func(*App) {})
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: '?'})
+	if _, ok := a.Overlay().(*helpOverlay); !ok {
+		t.Fatalf("? showed %T, want the help overlay", a.Overlay())
+	}
+	buf := screen.NewBuffer(60, 20, screen.DefaultDepth)
+	a.RenderTo(buf)
+	got := buf.ToString()
+	for _, want := range []string{
+		"Keyboard shortcuts",
+		refresh.String(), "Refresh",
+		"Show keyboard shortcuts",
+		"Quit",
+		"Toggle item", "Activate item", // From the focused list
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("help does not list %q:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "Refresh") > strings.Index(got, "Toggle item") {
+		t.Error("widget keys listed before the global bindings")
+	}
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyEscape})
+	if a.Overlay() != nil {
+		t.Error("Escape did not close the help")
+	}
+}
+
+func TestHelpLeavesUnfocusedWidgetKeysOut(t *testing.T) {
+	a := New().SetRoot(widget.NewList())
+	a.ShowHelp()
+	buf := screen.NewBuffer(60, 20, screen.DefaultDepth)
+	a.RenderTo(buf)
+	if got := buf.ToString(); strings.Contains(got, "Toggle item") {
+		t.Errorf("help lists the keys of an unfocused list:\n%s", got)
+	}
+}
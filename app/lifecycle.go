@@ -0,0 +1,51 @@
+package app
+
+import "github.com/agiles231/gotui/widget"
+
+// Initer is implemented by widgets that set up resources when the app
+// starts, such as registering a ticker or starting a goroutine
+type Initer interface {
+	// Init is called once Run has set up the screen, before the first render
+	Init(a *App)
+}
+
+// Disposer is implemented by widgets that release resources when the app
+// stops
+type Disposer interface {
+	// Dispose is called when Run returns, however it ends
+	Dispose()
+}
+
+// initTree calls Init on the root and its descendants, parents first
+func (a *App) initTree(w widget.Widget) {
+	if w == nil {
+		return
+	}
+	if i, ok := w.(Initer); ok {
+		i.Init(a)
+	}
+	for _, child := range children(w) {
+		a.initTree(child)
+	}
+}
+
+// disposeTree calls Dispose on the root and its descendants, children first
+func disposeTree(w widget.Widget) {
+	if w == nil {
+		return
+	}
+	for _, child := range children(w) {
+		disposeTree(child)
+	}
+	if d, ok := w.(Disposer); ok {
+		d.Dispose()
+	}
+}
+
+// children returns the children of w, or nil if it has none
+func children(w widget.Widget) []widget.Widget {
+	if parent, ok := w.(interface{ Children() []widget.Widget }); ok {
+		return parent.Children()
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// lifecycleWidget logs its Init, first Render and Dispose
+type lifecycleWidget struct {
+	widget.BaseWidget
+	name     string
+	log      *[]string
+	children []widget.Widget
+	rendered bool
+}
+
+func newLifecycleWidget(name string, log *[]string, children ...widget.Widget) *lifecycleWidget {
+	return &lifecycleWidget{BaseWidget: widget.NewBaseWidget(), name: name, log: log, children: children}
+}
+
+func (w *lifecycleWidget) Init(a *App) { *w.log = append(*w.log, "init "+w.name) }
+func (w *lifecycleWidget) Dispose()    { *w.log = append(*w.log, "dispose "+w.name) }
+
+func (w *lifecycleWidget) Render(buf *screen.Buffer, bounds layout.Rect) {
+	if !w.rendered {
+		w.rendered = true
+		*w.log = append(*w.log, "render "+w.name)
+	}
+	for _, child := range w.children {
+		child.Render(buf, bounds)
+	}
+}
+
+func (w *lifecycleWidget) Children() []widget.Widget          { return w.children }
+func (w *lifecycleWidget) HandleEvent(event input.Event) bool { return false }
+func (w *lifecycleWidget) Size() layout.Size                  { return layout.NewSize(1, 1) }
+func (w *lifecycleWidget) MinSize() layout.Size               { return layout.NewSize(1, 1) }
+
+func TestInitAndDispose(t *testing.T) {
+	openPTY(t)
+	var log []string
+	child := newLifecycleWidget("child", &log)
+	root := newLifecycleWidget("root", &log, widget.NewText("x"), child)
+	a := New().SetRoot(root)
+	a.Do(a.Quit)
+	if err := a.RunContext(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"init root", "init child", "render root", "render child", "dispose child", "dispose root"}
+	if !slices.Equal(log, want) {
+		t.Errorf("lifecycle = %v, want %v", log, want)
+	}
+}
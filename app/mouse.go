@@ -0,0 +1,151 @@
+package app
+
+import (
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/widget"
+)
+
+// defaultDoubleClickInterval is the longest gap between two left presses
+// at the same spot that still counts as a double-click
+const defaultDoubleClickInterval = 400 * time.Millisecond
+
+// EnableMouse sets whether the terminal reports mouse events
+// Must be called before Run
+func (a *App) EnableMouse(enabled bool) *App {
+	a.mouse = enabled
+	return a
+}
+
+// SetDoubleClickInterval sets the longest gap between two left presses at
+// the same spot that still counts as a double-click
+func (a *App) SetDoubleClickInterval(d time.Duration) *App {
+	a.doubleClick = d
+	return a
+}
+
+// markDoubleClick sets DoubleClick on a left press following another at
+// the same cell within the double-click interval
+// A third press starts over rather than completing another double-click
+func (a *App) markDoubleClick(e *input.MouseEvent) {
+	if e.Button != input.MouseLeft || e.Motion {
+		return
+	}
+	now := a.now()
+	e.DoubleClick = !a.lastClick.IsZero() && a.lastClickX == e.X && a.lastClickY == e.Y &&
+		now.Sub(a.lastClick) <= a.doubleClick
+	if e.DoubleClick {
+		a.lastClick = time.Time{}
+	} else {
+		a.lastClick = now
+	}
+	a.lastClickX, a.lastClickY = e.X, e.Y
+}
+
+// HitTest returns the topmost widget at (x, y), or nil if there is none
+// While an overlay is shown only the top overlay is searched
+func (a *App) HitTest(x, y int) widget.Widget {
+	path := a.hitPath(x, y)
+	if len(path) == 0 {
+		return nil
+	}
+	return path[len(path)-1]
+}
+
+// hitPath returns the widgets containing (x, y), from the root (or top
+// overlay) down to the topmost one
+func (a *App) hitPath(x, y int) []widget.Widget {
+	if top := a.Overlay(); top != nil {
+		return widget.HitPath(top, x, y)
+	}
+	if a.root == nil {
+		return nil
+	}
+	return widget.HitPath(a.root, x, y)
+}
+
+// handleMouse delivers a mouse event to the widget under the pointer, in
+// coordinates relative to that widget, marking double-clicks
+// A press focuses the nearest focusable widget under the pointer, and the
+// pressed widget keeps receiving events until the button is released. A
+// wheel event the widget doesn't use goes to its ancestors in turn, so a
+// scroll view scrolls over children that don't scroll themselves.
+func (a *App) handleMouse(e input.MouseEvent) bool {
+	a.markDoubleClick(&e)
+	target := a.mouseCapture
+	if target == nil {
+		path := a.hitPath(e.X, e.Y)
+		if len(path) == 0 {
+			return false
+		}
+		if isWheel(e) {
+			a.bubbleMouse(path, e)
+			return true
+		}
+		target = path[len(path)-1]
+		if isPress(e) {
+			a.focusHit(path)
+			a.mouseCapture = target
+		}
+	}
+	if e.Button == input.MouseRelease {
+		a.mouseCapture = nil
+	}
+
+	a.deliverMouse(target, e)
+	return true
+}
+
+// deliverMouse passes e to w in coordinates relative to w, returning
+// whether w used it
+func (a *App) deliverMouse(w widget.Widget, e input.MouseEvent) bool {
+	var bounds layout.Rect
+	if b, ok := w.(interface{ Bounds() layout.Rect }); ok {
+		bounds = b.Bounds()
+	}
+	return widget.HandleEventCtx(w, a.Context(), widget.LocalMouseEvent(e, bounds))
+}
+
+// bubbleMouse passes e to the widgets in path from the innermost outwards
+// until one uses it
+func (a *App) bubbleMouse(path []widget.Widget, e input.MouseEvent) {
+	for i := len(path) - 1; i >= 0; i-- {
+		if a.deliverMouse(path[i], e) {
+			return
+		}
+	}
+}
+
+// focusHit focuses the innermost widget in path that the focus manager
+// manages, leaving focus alone within overlays
+func (a *App) focusHit(path []widget.Widget) {
+	if a.Overlay() != nil {
+		return
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if a.focusManager.Contains(path[i]) {
+			a.focusManager.Focus(path[i])
+			return
+		}
+	}
+}
+
+// isWheel returns whether e is a wheel event
+func isWheel(e input.MouseEvent) bool {
+	return e.Button == input.MouseWheelUp || e.Button == input.MouseWheelDown
+}
+
+// isPress returns whether e is a button press, rather than a release, drag
+// or wheel event
+func isPress(e input.MouseEvent) bool {
+	if e.Motion {
+		return false
+	}
+	switch e.Button {
+	case input.MouseLeft, input.MouseMiddle, input.MouseRight:
+		return true
+	}
+	return false
+}
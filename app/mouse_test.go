@@ -0,0 +1,114 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// mouseRecorder is a focusable widget recording the mouse events it gets
+type mouseRecorder struct {
+	widget.BaseWidget
+	events []input.MouseEvent
+	use    bool // Whether HandleEvent reports the events as used
+	height int
+}
+
+func newMouseRecorder() *mouseRecorder {
+	r := &mouseRecorder{BaseWidget: widget.NewBaseWidget(), use: true, height: 3}
+	r.SetInteractive(true)
+	return r
+}
+
+func (r *mouseRecorder) Render(buf *screen.Buffer, bounds layout.Rect) {
+	r.SetBounds(bounds)
+}
+
+func (r *mouseRecorder) HandleEvent(event input.Event) bool {
+	e, ok := event.(input.MouseEvent)
+	if ok {
+		r.events = append(r.events, e)
+	}
+	return ok && r.use
+}
+
+func (r *mouseRecorder) Size() layout.Size    { return layout.NewSize(10, r.height) }
+func (r *mouseRecorder) MinSize() layout.Size { return layout.NewSize(1, 1) }
+
+// click returns a left press at (x, y)
+func click(x, y int) input.MouseEvent {
+	return input.MouseEvent{X: x, Y: y, Button: input.MouseLeft}
+}
+
+func TestClickRoutesToWidgetUnderPointer(t *testing.T) {
+	left, right := newMouseRecorder(), newMouseRecorder()
+	a := New().SetRoot(widget.NewSplitPane(layout.Horizontal, left, right))
+	a.FocusManager().Add(left)
+	a.FocusManager().Add(right)
+	a.RenderTo(screen.NewBuffer(21, 3, screen.DefaultDepth))
+
+	if got := a.HitTest(2, 1); got != left {
+		t.Errorf("HitTest(2, 1) = %v, want the left pane", got)
+	}
+	a.Dispatch(click(2, 1))
+	a.Dispatch(input.MouseEvent{X: 2, Y: 1, Button: input.MouseRelease})
+	if len(left.events) != 2 || len(right.events) != 0 {
+		t.Fatalf("left got %d events, right %d; want 2 and 0", len(left.events), len(right.events))
+	}
+	if !left.IsFocused() {
+		t.Error("clicking the left pane did not focus it")
+	}
+
+	x := right.Bounds().X + 3
+	a.Dispatch(click(x, 2))
+	if len(right.events) != 1 || len(left.events) != 2 {
+		t.Fatalf("right got %d events, left %d; want 1 and 2", len(right.events), len(left.events))
+	}
+	if got := right.events[0]; got.X != 3 || got.Y != 2 {
+		t.Errorf("right got a click at (%d, %d), want (3, 2) relative to it", got.X, got.Y)
+	}
+	if !right.IsFocused() || left.IsFocused() {
+		t.Error("clicking the right pane did not move focus to it")
+	}
+	if a.HitTest(50, 1) != nil {
+		t.Error("HitTest outside the UI found a widget")
+	}
+}
+
+func TestClickRoutesToTopOverlay(t *testing.T) {
+	root, overlay := newMouseRecorder(), newMouseRecorder()
+	a := New().SetRoot(root).PushOverlay(overlay)
+	a.RenderTo(screen.NewBuffer(30, 9, screen.DefaultDepth))
+
+	a.Dispatch(click(15, 4))
+	a.Dispatch(input.MouseEvent{X: 15, Y: 4, Button: input.MouseRelease})
+	a.Dispatch(click(0, 0)) // Outside the overlay, over the root
+	if len(overlay.events) != 2 || len(root.events) != 0 {
+		t.Errorf("overlay got %d events, root %d; want 2 and 0", len(overlay.events), len(root.events))
+	}
+}
+
+func TestWheelBubblesToScrollView(t *testing.T) {
+	child := newMouseRecorder()
+	child.use, child.height = false, 20
+	view := widget.NewScrollView(child)
+	a := New().SetRoot(view)
+	a.RenderTo(screen.NewBuffer(10, 5, screen.DefaultDepth))
+
+	a.Dispatch(input.MouseEvent{X: 2, Y: 2, Button: input.MouseWheelDown})
+	if len(child.events) != 1 {
+		t.Errorf("child got %d wheel events, want 1", len(child.events))
+	}
+	if view.Offset() != 3 {
+		t.Errorf("view offset = %d, want 3 after the child left the wheel", view.Offset())
+	}
+
+	child.use = true
+	a.Dispatch(input.MouseEvent{X: 2, Y: 2, Button: input.MouseWheelDown})
+	if view.Offset() != 3 {
+		t.Errorf("view offset = %d, want the wheel left to the child that used it", view.Offset())
+	}
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// overlayBaseZ is the z-layer of the bottom overlay; each overlay above it
+// is drawn one layer higher
+const overlayBaseZ = screen.DefaultDepth / 2
+
+// PushOverlay shows w centered above the UI at its preferred size
+// While an overlay is shown it receives all input instead of the root
+func (a *App) PushOverlay(w widget.Widget) *App {
+	if top := a.Overlay(); top != nil {
+		top.SetFocused(false)
+	}
+	a.overlays = append(a.overlays, w)
+	w.SetFocused(true)
+	a.RequestRender()
+	return a
+}
+
+// PopOverlay removes and returns the top overlay, or nil if there is none
+func (a *App) PopOverlay() widget.Widget {
+	if len(a.overlays) == 0 {
+		return nil
+	}
+	top := a.overlays[len(a.overlays)-1]
+	a.overlays = a.overlays[:len(a.overlays)-1]
+	top.SetFocused(false)
+	if next := a.Overlay(); next != nil {
+		next.SetFocused(true)
+	}
+	a.RequestRender()
+	return top
+}
+
+// Overlay returns the top overlay, or nil if there is none
+func (a *App) Overlay() widget.Widget {
+	if len(a.overlays) == 0 {
+		return nil
+	}
+	return a.overlays[len(a.overlays)-1]
+}
+
+// renderOverlays draws the overlays centered in bounds, bottom first
+// Each overlay hides what is drawn below it within its area
+func (a *App) renderOverlays(buf *screen.Buffer, bounds layout.Rect) {
+	for i, w := range a.overlays {
+		size := w.Size()
+		width := min(size.Width, bounds.Width)
+		height := min(size.Height, bounds.Height)
+		z := min(overlayBaseZ+i, buf.Depth()-1)
+		rect := layout.NewRect(
+			bounds.X+layout.Align(width, bounds.Width, layout.AlignCenter),
+			bounds.Y+layout.Align(height, bounds.Height, layout.AlignCenter),
+			z, width, height,
+		)
+		for below := 0; below <= z; below++ {
+			buf.FillRect(rect.X, rect.Y, below, rect.Width, rect.Height, screen.EmptyCell())
+		}
+		w.Render(buf, rect)
+	}
+}
@@ -0,0 +1,39 @@
+package app
+
+import "github.com/agiles231/gotui/widget"
+
+// SetCommands sets the commands offered by the command palette, in addition
+// to the global key bindings
+func (a *App) SetCommands(commands []widget.Command) *App {
+	a.commands = commands
+	return a
+}
+
+// ShowCommandPalette shows a modal command palette offering the commands
+// set with SetCommands followed by the global key bindings
+// Choosing a command closes the palette before the command runs
+func (a *App) ShowCommandPalette() {
+	commands := append([]widget.Command(nil), a.commands...)
+	for _, b := range a.bindings {
+		action := b.action
+		commands = append(commands, widget.Command{
+			Name:     b.description,
+			Shortcut: b.key.String(),
+			Run: func() {
+				if action != nil {
+					action(a)
+				}
+			},
+		})
+	}
+
+	palette := widget.NewCommandPalette().SetCommands(commands)
+	closePalette := func() {
+		if a.Overlay() == palette {
+			a.PopOverlay()
+		}
+	}
+	palette.OnRun(func(widget.Command) { closePalette() })
+	palette.OnClose(closePalette)
+	a.PushOverlay(palette)
+}
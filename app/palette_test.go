@@ -0,0 +1,83 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+func TestCommandPaletteRunsCommandsAndBindings(t *testing.T) {
+	a := New().SetRoot(widget.NewText(""))
+	var ran []string
+	a.SetCommands([]widget.Command{{Name: "Open project", Run: func() { ran = append(ran, "open") }}})
+	a.Bind(input.NewBinding(input.KeyF5, input.ModNone), "Refresh view", func(*App) {
+		if a.Overlay() != nil {
+			t.Error("binding ran with the palette still shown")
+		}
+		ran = append(ran, "refresh")
+	})
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: 'p', Modifier: input.ModCtrl})
+	palette, ok := a.Overlay().(*widget.CommandPalette)
+	if !ok {
+		t.Fatalf("Ctrl+P showed %T, want the command palette", a.Overlay())
+	}
+	if got := palette.Commands(); len(got) < 2 || got[0].Name != "Open project" {
+		t.Errorf("palette offers %v, want the commands then the bindings", got)
+	}
+
+	typeText(a, "refresh")
+	a.Dispatch(input.KeyEvent{Key: input.KeyEnter})
+	if len(ran) != 1 || ran[0] != "refresh" {
+		t.Errorf("ran %v, want the Refresh binding", ran)
+	}
+	if a.Overlay() != nil {
+		t.Error("palette still shown after running a command")
+	}
+
+	a.ShowCommandPalette()
+	a.Dispatch(input.KeyEvent{Key: input.KeyEscape})
+	if a.Overlay() != nil || len(ran) != 1 {
+		t.Error("Escape did not just close the palette")
+	}
+}
+
+// ctrlPWidget uses Ctrl+P while take is set, and no other key
+type ctrlPWidget struct {
+	widget.BaseWidget
+	take bool
+	used int
+}
+
+func (w *ctrlPWidget) Render(buf *screen.Buffer, bounds layout.Rect) {}
+func (w *ctrlPWidget) Size() layout.Size                             { return layout.NewSize(5, 1) }
+func (w *ctrlPWidget) MinSize() layout.Size                          { return layout.NewSize(1, 1) }
+
+func (w *ctrlPWidget) HandleEvent(event input.Event) bool {
+	if e, ok := event.(input.KeyEvent); ok && w.take && e.Key == input.KeyRune && e.Rune == 'p' && e.IsCtrl() {
+		w.used++
+		return true
+	}
+	return false
+}
+
+func TestCommandPaletteGivesCtrlPToWidgets(t *testing.T) {
+	w := &ctrlPWidget{BaseWidget: widget.NewBaseWidget(), take: true}
+	w.SetInteractive(true)
+	a := New().SetRoot(w)
+	ctrlP := input.KeyEvent{Key: input.KeyRune, Rune: 'p', Modifier: input.ModCtrl}
+
+	a.Dispatch(ctrlP)
+	if w.used != 1 || a.Overlay() != nil {
+		t.Fatalf("widget used Ctrl+P %d times and %T was shown, want the widget to take it", w.used, a.Overlay())
+	}
+
+	w.take = false
+	a.Dispatch(ctrlP)
+	if _, ok := a.Overlay().(*widget.CommandPalette); !ok {
+		t.Errorf("unused Ctrl+P showed %T, want the command palette", a.Overlay())
+	}
+}
@@ -0,0 +1,194 @@
+package app
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/widget"
+)
+
+// dialogMinWidth is the minimum outer width of prompt and confirm dialogs
+const dialogMinWidth = 30
+
+// dialog is a modal box with a title, an optional message, an optional text
+// input and OK/Cancel buttons
+// Enter accepts (unless Cancel is focused), Escape cancels, and Tab moves
+// focus between the input and the buttons
+type dialog struct {
+	widget.BaseWidget
+	title   string
+	message string
+	input   *widget.TextInput
+	ok      *widget.Button
+	cancel  *widget.Button
+	focus   int // Index into targets()
+	style   terminal.Style
+	onClose func(ok bool)
+}
+
+// newDialog creates a dialog; onClose is called once with the outcome
+func newDialog(title, message string, input *widget.TextInput, onClose func(ok bool)) *dialog {
+	d := &dialog{
+		BaseWidget: widget.NewBaseWidget(),
+		title:      title,
+		message:    message,
+		input:      input,
+		style:      terminal.DefaultStyle(),
+		onClose:    onClose,
+	}
+	d.ok = widget.NewButton("OK").OnPress(func() { d.close(true) })
+	d.cancel = widget.NewButton("Cancel").OnPress(func() { d.close(false) })
+	d.SetInteractive(true)
+	return d
+}
+
+// targets returns the focusable widgets in Tab order
+func (d *dialog) targets() []widget.Widget {
+	if d.input != nil {
+		return []widget.Widget{d.input, d.ok, d.cancel}
+	}
+	return []widget.Widget{d.ok, d.cancel}
+}
+
+// moveFocus moves focus by delta through the targets, wrapping around
+func (d *dialog) moveFocus(delta int) {
+	targets := d.targets()
+	targets[d.focus].SetFocused(false)
+	d.focus = (d.focus + delta + len(targets)) % len(targets)
+	targets[d.focus].SetFocused(true)
+}
+
+// close reports the outcome, only the first time it is called
+func (d *dialog) close(ok bool) {
+	if d.onClose == nil {
+		return
+	}
+	onClose := d.onClose
+	d.onClose = nil
+	onClose(ok)
+}
+
+// SetFocused sets focus state, passing it on to the focused target
+func (d *dialog) SetFocused(focused bool) {
+	d.BaseWidget.SetFocused(focused)
+	d.targets()[d.focus].SetFocused(focused)
+}
+
+// Render draws the dialog box
+func (d *dialog) Render(buf *screen.Buffer, bounds layout.Rect) {
+	d.SetBounds(bounds)
+	if !d.IsVisible() || bounds.Width < 4 || bounds.Height < 3 {
+		return
+	}
+
+	buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, d.style)
+	if d.title != "" {
+		title := screen.Truncate(" "+d.title+" ", bounds.Width-4, true)
+		buf.DrawString(bounds.X+2, bounds.Y, bounds.Z, title, d.style.WithBold())
+	}
+
+	inner := bounds.Inset(1, 2, 1, 2)
+	y := inner.Y
+	if d.message != "" {
+		buf.DrawString(inner.X, y, inner.Z, screen.Truncate(d.message, inner.Width, true), d.style)
+		y++
+	}
+	if d.input != nil {
+		d.input.SetWidth(inner.Width)
+		d.input.Render(buf, layout.NewRect(inner.X, y, inner.Z, inner.Width, 1))
+	}
+
+	// Buttons on the last inner line, centered
+	buttonsY := inner.Y + inner.Height - 1
+	okWidth, cancelWidth := d.ok.Size().Width, d.cancel.Size().Width
+	x := inner.X + layout.Align(okWidth+1+cancelWidth, inner.Width, layout.AlignCenter)
+	d.ok.Render(buf, layout.NewRect(x, buttonsY, inner.Z, okWidth, 1))
+	d.cancel.Render(buf, layout.NewRect(x+okWidth+1, buttonsY, inner.Z, cancelWidth, 1))
+}
+
+// HandleEvent handles input events
+func (d *dialog) HandleEvent(event input.Event) bool {
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok {
+		return false
+	}
+
+	switch keyEvent.Key {
+	case input.KeyEscape:
+		d.close(false)
+		return true
+	case input.KeyTab:
+		if keyEvent.IsShift() {
+			d.moveFocus(-1)
+		} else {
+			d.moveFocus(1)
+		}
+		return true
+	case input.KeyEnter:
+		d.close(d.targets()[d.focus] != d.cancel)
+		return true
+	case input.KeyLeft, input.KeyRight:
+		if d.targets()[d.focus] == d.input {
+			break
+		}
+		if keyEvent.Key == input.KeyLeft {
+			d.moveFocus(-1)
+		} else {
+			d.moveFocus(1)
+		}
+		return true
+	}
+
+	return d.targets()[d.focus].HandleEvent(event)
+}
+
+// Size returns the preferred size
+func (d *dialog) Size() layout.Size {
+	width := max(dialogMinWidth, screen.DisplayWidth(d.title)+6, screen.DisplayWidth(d.message)+4)
+	height := 4 // Borders, blank line and buttons
+	if d.message != "" {
+		height++
+	}
+	if d.input != nil {
+		height++
+	}
+	return layout.NewSize(width, height)
+}
+
+// MinSize returns the minimum size
+func (d *dialog) MinSize() layout.Size {
+	return layout.NewSize(dialogMinWidth, 4)
+}
+
+// Prompt shows a modal asking for a single value
+// onDone receives the typed value and ok=true on Enter or OK, or ok=false on
+// Escape or Cancel
+func (a *App) Prompt(title, placeholder string, onDone func(value string, ok bool)) {
+	field := widget.NewTextInput().SetPlaceholder(placeholder)
+	d := newDialog(title, "", field, nil)
+	d.onClose = func(ok bool) {
+		if a.Overlay() == d {
+			a.PopOverlay()
+		}
+		if onDone != nil {
+			onDone(field.Value(), ok)
+		}
+	}
+	a.PushOverlay(d)
+}
+
+// Confirm shows a modal asking a yes/no question
+// onDone receives true on Enter or OK, or false on Escape or Cancel
+func (a *App) Confirm(message string, onDone func(bool)) {
+	d := newDialog("Confirm", message, nil, nil)
+	d.onClose = func(ok bool) {
+		if a.Overlay() == d {
+			a.PopOverlay()
+		}
+		if onDone != nil {
+			onDone(ok)
+		}
+	}
+	a.PushOverlay(d)
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// typeText dispatches a key event for each rune of s
+func typeText(a *App, s string) {
+	for _, r := range s {
+		a.Dispatch(input.KeyEvent{Key: input.KeyRune, Rune: r})
+	}
+}
+
+func TestPromptEnterReturnsValue(t *testing.T) {
+	a := New()
+	var got string
+	var gotOK, called bool
+	a.Prompt("Name", "", func(value string, ok bool) { got, gotOK, called = value, ok, true })
+
+	typeText(a, "Ada")
+	a.Dispatch(input.KeyEvent{Key: input.KeyEnter})
+	if !called || got != "Ada" || !gotOK {
+		t.Errorf("onDone(%q, %v), called %v; want (\"Ada\", true)", got, gotOK, called)
+	}
+	if a.Overlay() != nil {
+		t.Error("prompt still shown after Enter")
+	}
+}
+
+func TestPromptEscapeCancels(t *testing.T) {
+	a := New()
+	gotOK, called := true, false
+	a.Prompt("Name", "", func(value string, ok bool) { gotOK, called = ok, true })
+
+	typeText(a, "Ada")
+	a.Dispatch(input.KeyEvent{Key: input.KeyEscape})
+	if !called || gotOK {
+		t.Errorf("onDone ok = %v, called %v; want ok false", gotOK, called)
+	}
+	if a.Overlay() != nil {
+		t.Error("prompt still shown after Escape")
+	}
+}
+
+func TestConfirmLeavesOtherOverlays(t *testing.T) {
+	a := New()
+	var answers []bool
+	a.Confirm("Sure?", func(ok bool) {
+		answers = append(answers, ok)
+		// Showing another dialog from the callback keeps it on top
+		a.Confirm("Really?", func(ok bool) { answers = append(answers, ok) })
+	})
+
+	a.Dispatch(input.KeyEvent{Key: input.KeyEnter})
+	if len(answers) != 1 || !answers[0] || a.Overlay() == nil {
+		t.Fatalf("answers %v, overlay %v; want the second dialog shown", answers, a.Overlay())
+	}
+	a.Dispatch(input.KeyEvent{Key: input.KeyEscape})
+	if len(answers) != 2 || answers[1] || a.Overlay() != nil {
+		t.Errorf("answers %v, overlay %v; want [true false] and no overlay", answers, a.Overlay())
+	}
+}
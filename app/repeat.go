@@ -0,0 +1,31 @@
+package app
+
+import (
+	"time"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// SetKeyRepeatInterval sets the longest gap between two events for the
+// same key that marks the second one as a repeat, or 0 (the default) to
+// not mark repeats
+// Terminals don't report key releases, so a held key shows up as the same
+// key arriving quickly over and over; an interval a little longer than the
+// terminal's repeat rate (typically 30-50ms) tells those apart from
+// separate presses.
+func (a *App) SetKeyRepeatInterval(d time.Duration) *App {
+	a.keyRepeat = d
+	return a
+}
+
+// markRepeat sets Repeat on e if it is the same key as the last key event
+// and arrived within the key repeat interval
+func (a *App) markRepeat(e input.KeyEvent) input.KeyEvent {
+	key := e
+	key.Repeat = false
+	now := a.now()
+	e.Repeat = a.keyRepeat > 0 && !a.lastKeyTime.IsZero() && key == a.lastKey &&
+		now.Sub(a.lastKeyTime) <= a.keyRepeat
+	a.lastKey, a.lastKeyTime = key, now
+	return e
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestDoubleClick(t *testing.T) {
+	tests := []struct {
+		name   string
+		gap    time.Duration
+		second input.MouseEvent
+		want   bool
+	}{
+		{"within interval", 300 * time.Millisecond, click(3, 1), true},
+		{"after interval", 500 * time.Millisecond, click(3, 1), false},
+		{"elsewhere", 100 * time.Millisecond, click(4, 1), false},
+		{"right button", 100 * time.Millisecond, input.MouseEvent{X: 3, Y: 1, Button: input.MouseRight}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(0, 0)
+			a := New().SetDoubleClickInterval(400 * time.Millisecond)
+			a.now = func() time.Time { return now }
+
+			first := click(3, 1)
+			a.markDoubleClick(&first)
+			if first.DoubleClick {
+				t.Fatal("first click marked as a double-click")
+			}
+			now = now.Add(tt.gap)
+			second := tt.second
+			a.markDoubleClick(&second)
+			if second.DoubleClick != tt.want {
+				t.Errorf("second click DoubleClick = %v, want %v", second.DoubleClick, tt.want)
+			}
+		})
+	}
+}
+
+func TestTripleClickIsNotTwoDoubleClicks(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New()
+	a.now = func() time.Time { return now }
+	var got []bool
+	for range 3 {
+		e := click(0, 0)
+		a.markDoubleClick(&e)
+		got = append(got, e.DoubleClick)
+		now = now.Add(50 * time.Millisecond)
+	}
+	if got[0] || !got[1] || got[2] {
+		t.Errorf("DoubleClick for three clicks = %v, want [false true false]", got)
+	}
+}
+
+func TestKeyRepeat(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New()
+	a.now = func() time.Time { return now }
+	key := input.KeyEvent{Key: input.KeyRune, Rune: 'j'}
+
+	// Repeats aren't marked until an interval is set
+	a.markRepeat(key)
+	now = now.Add(10 * time.Millisecond)
+	if a.markRepeat(key).Repeat {
+		t.Error("Repeat marked without a key repeat interval")
+	}
+
+	a.SetKeyRepeatInterval(50 * time.Millisecond)
+	now = now.Add(30 * time.Millisecond)
+	if !a.markRepeat(key).Repeat {
+		t.Error("Repeat = false for the same key within the interval, want true")
+	}
+	now = now.Add(80 * time.Millisecond)
+	if a.markRepeat(key).Repeat {
+		t.Error("Repeat = true for the same key after the interval, want false")
+	}
+	now = now.Add(10 * time.Millisecond)
+	if a.markRepeat(input.KeyEvent{Key: input.KeyRune, Rune: 'k'}).Repeat {
+		t.Error("Repeat = true for a different key, want false")
+	}
+}
@@ -0,0 +1,34 @@
+package app
+
+import "time"
+
+// SetResizePolling makes the app check the terminal size every interval,
+// for environments that don't reliably deliver SIGWINCH
+// A new size is applied once two consecutive polls agree on it, so rapid
+// changes produce a single resize. An interval of 0 disables polling.
+// Must be called before Run
+func (a *App) SetResizePolling(interval time.Duration) *App {
+	a.resizePoll = interval
+	return a
+}
+
+// pollResize checks the terminal size and resizes once a changed size has
+// been stable for one poll interval
+func (a *App) pollResize() {
+	width, height, err := a.terminalSize()
+	if err != nil {
+		return
+	}
+	height = a.screenHeight(height)
+	size := [2]int{width, height}
+	if width == a.screen.Width() && height == a.screen.Height() {
+		a.pendingSize = [2]int{}
+		return
+	}
+	if size != a.pendingSize {
+		a.pendingSize = size
+		return
+	}
+	a.pendingSize = [2]int{}
+	a.resize(width, height)
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/widget"
+)
+
+func TestPollResizeFiresOncePerSize(t *testing.T) {
+	a, _ := newScreenApp(widget.NewText(""), 80, 24)
+	size := [2]int{80, 24}
+	a.terminalSize = func() (int, int, error) { return size[0], size[1], nil }
+	var resizes [][2]int
+	a.OnResize(func(_ *App, width, height int) {
+		resizes = append(resizes, [2]int{width, height})
+	})
+
+	a.pollResize()
+	size = [2]int{100, 30}
+	a.pollResize() // Seen once, not yet stable
+	a.pollResize()
+	a.pollResize()
+	size = [2]int{90, 20}
+	a.pollResize()
+	size = [2]int{120, 40} // Changed again before the next poll agreed
+	a.pollResize()
+	a.pollResize()
+
+	want := [][2]int{{100, 30}, {120, 40}}
+	if len(resizes) != len(want) {
+		t.Fatalf("resizes = %v, want %v", resizes, want)
+	}
+	for i := range want {
+		if resizes[i] != want[i] {
+			t.Errorf("resizes = %v, want %v", resizes, want)
+			break
+		}
+	}
+	if a.screen.Width() != 120 || a.screen.Height() != 40 {
+		t.Errorf("screen is %dx%d, want 120x40", a.screen.Width(), a.screen.Height())
+	}
+}
+
+func TestPollResizeInline(t *testing.T) {
+	a, _ := newScreenApp(widget.NewText(""), 80, 5)
+	a.SetInline(5)
+	size := [2]int{80, 24}
+	a.terminalSize = func() (int, int, error) { return size[0], size[1], nil }
+	var resizes [][2]int
+	a.OnResize(func(_ *App, width, height int) {
+		resizes = append(resizes, [2]int{width, height})
+	})
+
+	// A terminal taller than the inline rows is already the screen's size
+	a.pollResize()
+	if a.pendingSize != [2]int{} {
+		t.Errorf("pending size %v for an unchanged inline screen, want none", a.pendingSize)
+	}
+	size = [2]int{100, 30}
+	a.pollResize()
+	a.pollResize()
+	a.pollResize()
+
+	if len(resizes) != 1 || resizes[0] != [2]int{100, 5} {
+		t.Errorf("resizes = %v, want one to 100x5", resizes)
+	}
+	if a.pendingSize != [2]int{} {
+		t.Errorf("pending size %v after the resize, want none", a.pendingSize)
+	}
+}
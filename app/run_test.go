@@ -0,0 +1,208 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/widget"
+	"golang.org/x/sys/unix"
+)
+
+// openPTY opens a pseudo-terminal and makes its slave side stdin and
+// stdout until the test ends, so Run can be tested without a real terminal
+// The master side is drained so output never blocks.
+func openPTY(t *testing.T) *os.File {
+	t.Helper()
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("no pseudo-terminal: %v", err)
+	}
+	fd := int(master.Fd())
+	if err := unix.IoctlSetPointerInt(fd, unix.TIOCSPTLCK, 0); err != nil {
+		master.Close()
+		t.Skipf("unlocking pseudo-terminal: %v", err)
+	}
+	n, err := unix.IoctlGetInt(fd, unix.TIOCGPTN)
+	if err != nil {
+		master.Close()
+		t.Skipf("pseudo-terminal number: %v", err)
+	}
+	slave, err := os.OpenFile("/dev/pts/"+strconv.Itoa(n), os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		master.Close()
+		t.Skipf("opening pseudo-terminal: %v", err)
+	}
+	unix.IoctlSetWinsize(int(slave.Fd()), unix.TIOCSWINSZ, &unix.Winsize{Row: 10, Col: 40})
+	go io.Copy(io.Discard, master)
+
+	stdin, stdout := os.Stdin, os.Stdout
+	os.Stdin, os.Stdout = slave, slave
+	t.Cleanup(func() {
+		os.Stdin, os.Stdout = stdin, stdout
+		slave.Close()
+		master.Close()
+	})
+	return slave
+}
+
+func TestRunContextCancel(t *testing.T) {
+	tty := openPTY(t)
+	before, err := unix.IoctlGetTermios(int(tty.Fd()), unix.TCGETS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := New()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.RunContext(ctx) }()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("RunContext() = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("RunContext did not return after cancel")
+	}
+
+	after, err := unix.IoctlGetTermios(int(tty.Fd()), unix.TCGETS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *after != *before {
+		t.Error("terminal settings not restored after cancel")
+	}
+}
+
+func TestRunContextDeadline(t *testing.T) {
+	openPTY(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := New().RunContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RunContext() = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+// counterWidget draws nothing but records the counter it saw at each render
+type counterWidget struct {
+	widget.BaseWidget
+	count int
+	seen  int
+}
+
+func (w *counterWidget) Render(buf *screen.Buffer, bounds layout.Rect) { w.seen = w.count }
+func (w *counterWidget) HandleEvent(event input.Event) bool            { return false }
+func (w *counterWidget) Size() layout.Size                             { return layout.NewSize(1, 1) }
+func (w *counterWidget) MinSize() layout.Size                          { return layout.NewSize(1, 1) }
+
+func TestDoRunsOnLoopBeforeRender(t *testing.T) {
+	openPTY(t)
+	w := &counterWidget{BaseWidget: widget.NewBaseWidget()}
+	a := New().SetRoot(w)
+	frames := make(chan int, 100)
+	a.OnFrame(func(FrameStats) { frames <- w.seen })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.RunContext(ctx) }()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	next := func() int {
+		select {
+		case seen := <-frames:
+			return seen
+		case <-time.After(time.Second):
+			t.Fatal("no frame rendered")
+			return 0
+		}
+	}
+	next() // Initial frame
+
+	// Many goroutines update the widget without locking; Do serializes the
+	// updates with rendering, so none are lost
+	const workers = 50
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.Do(func() { w.count++ })
+		}()
+	}
+	wg.Wait()
+	a.Do(func() {})
+	for seen := next(); seen != workers; seen = next() {
+		if seen > workers {
+			t.Fatalf("frame saw %d updates, want at most %d", seen, workers)
+		}
+	}
+
+	// The frame after a Do sees its effect
+	a.Do(func() { w.count = 100 })
+	if seen := next(); seen != 100 {
+		t.Errorf("frame after Do saw %d, want 100", seen)
+	}
+}
+
+func TestDoRunsInOrder(t *testing.T) {
+	a := New()
+	var got []int
+	for i := range 3 {
+		a.Do(func() { got = append(got, i) })
+	}
+	// Functions queued from a queued function run after it
+	a.Do(func() { a.Do(func() { got = append(got, 4) }) })
+	a.runQueued()
+	a.runQueued()
+	if !slices.Equal(got, []int{0, 1, 2, 4}) {
+		t.Errorf("Do ran %v, want [0 1 2 4]", got)
+	}
+}
+
+func TestRunSetsAndRestoresTitle(t *testing.T) {
+	openPTY(t)
+	var out bytes.Buffer
+	a := New().SetOutput(&out).SetTitle("Editor")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	a.RunContext(ctx)
+
+	s := out.String()
+	save := strings.Index(s, terminal.TitleSave)
+	set := strings.Index(s, terminal.TitleSequence("Editor"))
+	restore := strings.LastIndex(s, terminal.TitleRestore)
+	if save < 0 || set < save || restore < set {
+		t.Errorf("output %q does not save, set and restore the title in order", s)
+	}
+}
+
+func TestSetTitleWhileRunning(t *testing.T) {
+	a, _ := newScreenApp(widget.NewText(""), 2, 1)
+	out := a.screen.Writer().(*bytes.Buffer)
+	a.SetTitle("Two")
+	if want := terminal.TitleSequence("Two"); !strings.Contains(out.String(), want) {
+		t.Errorf("output %q does not contain %q", out.String(), want)
+	}
+	if a.Title() != "Two" {
+		t.Errorf("Title() = %q, want %q", a.Title(), "Two")
+	}
+}
@@ -0,0 +1,45 @@
+package app
+
+import "time"
+
+// frameWindow is the number of recent frames averaged by AverageFrameTime
+const frameWindow = 60
+
+// FrameStats describes a single rendered frame
+type FrameStats struct {
+	Time         time.Time     // When rendering started
+	Duration     time.Duration // Time spent drawing and writing the frame
+	Bytes        int           // Bytes written to the terminal
+	CellsChanged int           // Cells that differed from the previous frame
+}
+
+// OnFrame sets a callback invoked after every rendered frame
+func (a *App) OnFrame(fn func(FrameStats)) *App {
+	a.onFrame = fn
+	return a
+}
+
+// AverageFrameTime returns the average duration of the recent frames
+func (a *App) AverageFrameTime() time.Duration {
+	if len(a.frameTimes) == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, d := range a.frameTimes {
+		total += d
+	}
+	return total / time.Duration(len(a.frameTimes))
+}
+
+// recordFrame adds a frame to the rolling average and reports it
+func (a *App) recordFrame(stats FrameStats) {
+	if len(a.frameTimes) < frameWindow {
+		a.frameTimes = append(a.frameTimes, stats.Duration)
+	} else {
+		a.frameTimes[a.frameCount%frameWindow] = stats.Duration
+	}
+	a.frameCount++
+	if a.onFrame != nil {
+		a.onFrame(stats)
+	}
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// slowText is a Text that advances a fake clock while it renders
+type slowText struct {
+	*widget.Text
+	now  *time.Time
+	cost time.Duration
+}
+
+func (s slowText) Render(buf *screen.Buffer, bounds layout.Rect) {
+	*s.now = s.now.Add(s.cost)
+	s.Text.Render(buf, bounds)
+}
+
+func TestFrameStats(t *testing.T) {
+	now := time.Unix(100, 0)
+	root := slowText{Text: widget.NewText("abc"), now: &now, cost: 4 * time.Millisecond}
+	a, frames := newScreenApp(root, 5, 1)
+	a.now = func() time.Time { return now }
+
+	a.render()
+	if len(*frames) != 1 {
+		t.Fatalf("got %d frames, want 1", len(*frames))
+	}
+	stats := (*frames)[0]
+	if stats.CellsChanged != 3 {
+		t.Errorf("CellsChanged = %d, want 3", stats.CellsChanged)
+	}
+	if want := a.screen.Writer().(*bytes.Buffer).Len(); stats.Bytes != want {
+		t.Errorf("Bytes = %d, want %d", stats.Bytes, want)
+	}
+	if stats.Duration != 4*time.Millisecond {
+		t.Errorf("Duration = %v, want 4ms", stats.Duration)
+	}
+	if !stats.Time.Equal(time.Unix(100, 0)) {
+		t.Errorf("Time = %v, want the start of the render", stats.Time)
+	}
+
+	a.render()
+	if got := (*frames)[1]; got.CellsChanged != 0 || got.Bytes != 0 {
+		t.Errorf("unchanged frame = %+v, want no cells or bytes", got)
+	}
+	if got := a.AverageFrameTime(); got != 4*time.Millisecond {
+		t.Errorf("AverageFrameTime = %v, want 4ms", got)
+	}
+}
+
+func TestAverageFrameTimeWindow(t *testing.T) {
+	a := New()
+	if got := a.AverageFrameTime(); got != 0 {
+		t.Errorf("AverageFrameTime before any frame = %v, want 0", got)
+	}
+	for i := 0; i < frameWindow; i++ {
+		a.recordFrame(FrameStats{Duration: time.Millisecond})
+	}
+	for i := 0; i < frameWindow; i++ {
+		a.recordFrame(FrameStats{Duration: 3 * time.Millisecond})
+	}
+	if got := a.AverageFrameTime(); got != 3*time.Millisecond {
+		t.Errorf("AverageFrameTime = %v, want only the last %d frames averaged", got, frameWindow)
+	}
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"container/heap"
+	"time"
+)
+
+// TimerID identifies a timer started with Every or After
+type TimerID int
+
+// timer is a callback waiting for its deadline
+type timer struct {
+	id       TimerID
+	when     time.Time
+	interval time.Duration // Time between runs, 0 to run once
+	every    func(*App, time.Time) bool
+	after    func(*App)
+	index    int // Position in the heap
+}
+
+// timerHeap orders timers by deadline, soonest first
+type timerHeap []*timer
+
+func (h timerHeap) Len() int           { return len(h) }
+func (h timerHeap) Less(i, j int) bool { return h[i].when.Before(h[j].when) }
+
+func (h timerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *timerHeap) Push(x any) {
+	t := x.(*timer)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *timerHeap) Pop() any {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	t.index = -1
+	return t
+}
+
+// Every calls fn every interval on the event loop until the timer is
+// cancelled, starting one interval from now
+// Return true from fn to request a render. Runs that fall behind are
+// skipped rather than run back to back.
+func (a *App) Every(interval time.Duration, fn func(*App, time.Time) bool) TimerID {
+	if interval <= 0 {
+		interval = defaultTickInterval
+	}
+	return a.addTimer(&timer{when: a.now().Add(interval), interval: interval, every: fn})
+}
+
+// After calls fn once on the event loop after d, and then renders
+func (a *App) After(d time.Duration, fn func(*App)) TimerID {
+	return a.addTimer(&timer{when: a.now().Add(d), after: fn})
+}
+
+// CancelTimer stops a timer started with Every or After
+// Returns false if it has already finished or been cancelled.
+func (a *App) CancelTimer(id TimerID) bool {
+	for _, t := range a.timers {
+		if t.id == id {
+			heap.Remove(&a.timers, t.index)
+			return true
+		}
+	}
+	return false
+}
+
+// addTimer schedules t, giving it a new ID
+func (a *App) addTimer(t *timer) TimerID {
+	a.lastTimerID++
+	t.id = a.lastTimerID
+	heap.Push(&a.timers, t)
+	return t.id
+}
+
+// nextTimer returns the deadline of the soonest timer
+func (a *App) nextTimer() (time.Time, bool) {
+	if len(a.timers) == 0 {
+		return time.Time{}, false
+	}
+	return a.timers[0].when, true
+}
+
+// runTimers runs the timers due by now, soonest first
+// Returns true if any requested a render.
+func (a *App) runTimers(now time.Time) bool {
+	redraw := false
+	for len(a.timers) > 0 && !a.timers[0].when.After(now) {
+		t := a.timers[0]
+		if t.interval == 0 {
+			heap.Pop(&a.timers)
+			t.after(a)
+			redraw = true
+			continue
+		}
+
+		t.when = t.when.Add(t.interval)
+		if !t.when.After(now) {
+			t.when = now.Add(t.interval)
+		}
+		heap.Fix(&a.timers, t.index)
+		if t.every(a, now) {
+			redraw = true
+		}
+	}
+	return redraw
+}
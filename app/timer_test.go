@@ -0,0 +1,77 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimersFireAtOwnCadence(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New()
+	a.now = func() time.Time { return now }
+
+	fast, slow, once := 0, 0, 0
+	a.Every(100*time.Millisecond, func(*App, time.Time) bool { fast++; return false })
+	slowID := a.Every(250*time.Millisecond, func(*App, time.Time) bool { slow++; return false })
+	a.After(300*time.Millisecond, func(*App) { once++ })
+
+	step := func(until time.Duration) {
+		for end := time.Unix(0, 0).Add(until); now.Before(end); {
+			now = now.Add(10 * time.Millisecond)
+			a.runTimers(now)
+		}
+	}
+	step(500 * time.Millisecond)
+	if fast != 5 || slow != 2 || once != 1 {
+		t.Fatalf("after 500ms fired fast %d, slow %d, once %d; want 5, 2, 1", fast, slow, once)
+	}
+
+	if !a.CancelTimer(slowID) {
+		t.Fatal("CancelTimer() = false for a running timer")
+	}
+	if a.CancelTimer(slowID) {
+		t.Error("CancelTimer() = true for a cancelled timer")
+	}
+	step(1000 * time.Millisecond)
+	if fast != 10 || slow != 2 || once != 1 {
+		t.Errorf("after 1s fired fast %d, slow %d, once %d; want 10, 2, 1", fast, slow, once)
+	}
+}
+
+func TestTimerRequestsRender(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New()
+	a.now = func() time.Time { return now }
+	redraw := false
+	a.Every(time.Second, func(*App, time.Time) bool { return redraw })
+
+	now = now.Add(time.Second)
+	if a.runTimers(now) {
+		t.Error("runTimers() = true when the timer returned false")
+	}
+	redraw = true
+	now = now.Add(time.Second)
+	if !a.runTimers(now) {
+		t.Error("runTimers() = false when the timer returned true")
+	}
+	if a.runTimers(now) {
+		t.Error("runTimers() = true with no timer due")
+	}
+}
+
+func TestTimerSkipsMissedRuns(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New()
+	a.now = func() time.Time { return now }
+	runs := 0
+	a.Every(100*time.Millisecond, func(*App, time.Time) bool { runs++; return false })
+
+	now = now.Add(time.Second)
+	a.runTimers(now)
+	if runs != 1 {
+		t.Errorf("timer ran %d times after falling behind, want 1", runs)
+	}
+	if when, _ := a.nextTimer(); !when.Equal(now.Add(100 * time.Millisecond)) {
+		t.Errorf("next run at %v, want one interval from now", when.Sub(time.Unix(0, 0)))
+	}
+}
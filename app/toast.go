@@ -0,0 +1,106 @@
+package app
+
+import (
+	"time"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// Corner is a corner of the screen
+type Corner int
+
+const (
+	CornerTopLeft Corner = iota
+	CornerTopRight
+	CornerBottomLeft
+	CornerBottomRight
+)
+
+// defaultMaxToasts is the default number of toasts shown at once
+const defaultMaxToasts = 3
+
+// toast is a transient message shown until it expires
+type toast struct {
+	text    string
+	expires time.Time
+}
+
+// Notify shows a transient message in the toast corner for duration
+// Newer toasts are drawn closest to the corner with older ones stacked
+// behind them; only the newest maxToasts are shown
+func (a *App) Notify(text string, duration time.Duration) *App {
+	a.toasts = append(a.toasts, toast{text: text, expires: a.now().Add(duration)})
+	a.RequestRender()
+	return a
+}
+
+// SetToastCorner sets the corner toasts are shown in
+func (a *App) SetToastCorner(corner Corner) *App {
+	a.toastCorner = corner
+	return a
+}
+
+// SetMaxToasts sets how many toasts are shown at once
+func (a *App) SetMaxToasts(n int) *App {
+	a.maxToasts = max(1, n)
+	return a
+}
+
+// expireToasts removes toasts that expired by now
+// Returns true if any were removed
+func (a *App) expireToasts(now time.Time) bool {
+	kept := a.toasts[:0]
+	for _, t := range a.toasts {
+		if now.Before(t.expires) {
+			kept = append(kept, t)
+		}
+	}
+	removed := len(kept) != len(a.toasts)
+	a.toasts = kept
+	return removed
+}
+
+// renderToasts draws the newest toasts stacked from the toast corner,
+// on the top layer above the UI and any overlays
+func (a *App) renderToasts(buf *screen.Buffer, bounds layout.Rect) {
+	now := a.now()
+	z := buf.Depth() - 1
+	style := terminal.DefaultStyle()
+	top := a.toastCorner == CornerTopLeft || a.toastCorner == CornerTopRight
+	left := a.toastCorner == CornerTopLeft || a.toastCorner == CornerBottomLeft
+
+	offset := 0
+	shown := 0
+	for i := len(a.toasts) - 1; i >= 0 && shown < a.maxToasts; i-- {
+		t := a.toasts[i]
+		if !now.Before(t.expires) {
+			continue
+		}
+		shown++
+
+		text := screen.Truncate(t.text, bounds.Width-4, true)
+		width := min(screen.DisplayWidth(text)+4, bounds.Width)
+		height := 3
+		if offset+height > bounds.Height {
+			break
+		}
+
+		x := bounds.X + bounds.Width - width
+		if left {
+			x = bounds.X
+		}
+		y := bounds.Y + bounds.Height - offset - height
+		if top {
+			y = bounds.Y + offset
+		}
+
+		for below := 0; below <= z; below++ {
+			buf.FillRect(x, y, below, width, height, screen.EmptyCell())
+		}
+		buf.DrawBox(x, y, z, width, height, style)
+		buf.DrawString(x+2, y+1, z, text, style)
+		offset += height
+	}
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// renderLines renders a into a new buffer and returns its rows
+func renderLines(a *App, width, height int) []string {
+	buf := screen.NewBuffer(width, height, screen.DefaultDepth)
+	a.RenderTo(buf)
+	return strings.Split(buf.ToString(), "\n")
+}
+
+func TestToastsStackAndExpire(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New().SetRoot(widget.NewText(""))
+	a.now = func() time.Time { return now }
+
+	a.Notify("first", 2*time.Second)
+	a.Notify("second", 5*time.Second)
+	lines := renderLines(a, 20, 8)
+	if !strings.Contains(lines[6], "second") {
+		t.Errorf("row 6 = %q, want the newest toast nearest the corner", lines[6])
+	}
+	if !strings.Contains(lines[3], "first") {
+		t.Errorf("row 3 = %q, want the older toast stacked above", lines[3])
+	}
+	if !strings.HasSuffix(strings.TrimRight(lines[6], " "), "│") {
+		t.Errorf("row 6 = %q, want the toast against the right edge", lines[6])
+	}
+
+	now = now.Add(3 * time.Second)
+	if !a.tick(now) {
+		t.Error("tick() = false after a toast expired, want true")
+	}
+	lines = renderLines(a, 20, 8)
+	if strings.Contains(strings.Join(lines, "\n"), "first") {
+		t.Error("expired toast still drawn")
+	}
+	if !strings.Contains(lines[6], "second") {
+		t.Errorf("row 6 = %q, want the remaining toast in the corner", lines[6])
+	}
+}
+
+func TestToastCornerAndLimit(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := New().SetRoot(widget.NewText("")).SetToastCorner(CornerTopLeft).SetMaxToasts(1)
+	a.now = func() time.Time { return now }
+
+	a.Notify("old", time.Second)
+	a.Notify("new", time.Second)
+	lines := renderLines(a, 20, 8)
+	if !strings.HasPrefix(lines[1], "│ new") {
+		t.Errorf("row 1 = %q, want the newest toast in the top-left corner", lines[1])
+	}
+	if strings.Contains(strings.Join(lines, "\n"), "old") {
+		t.Error("toast beyond the limit drawn")
+	}
+}
@@ -4,4 +4,4 @@ go 1.24.0
 
 toolchain go1.24.11
 
-require golang.org/x/sys v0.38.0 // indirect
+require golang.org/x/sys v0.38.0
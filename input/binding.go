@@ -0,0 +1,56 @@
+package input
+
+import "strings"
+
+// Binding is a key combination, such as Ctrl+Q or F1
+type Binding struct {
+	Key      Key
+	Rune     rune // Character for KeyRune bindings
+	Modifier Modifier
+}
+
+// NewBinding creates a binding for a special key
+func NewBinding(key Key, mod Modifier) Binding {
+	return Binding{Key: key, Modifier: mod}
+}
+
+// RuneBinding creates a binding for a character key
+func RuneBinding(r rune, mod Modifier) Binding {
+	return Binding{Key: KeyRune, Rune: r, Modifier: mod}
+}
+
+// Matches checks if a key event is this key combination
+func (b Binding) Matches(e KeyEvent) bool {
+	if e.Key != b.Key || e.Modifier != b.Modifier {
+		return false
+	}
+	return b.Key != KeyRune || e.Rune == b.Rune
+}
+
+// String returns a human-readable name such as "Ctrl+Q"
+func (b Binding) String() string {
+	var sb strings.Builder
+	if b.Modifier&ModCtrl != 0 {
+		sb.WriteString("Ctrl+")
+	}
+	if b.Modifier&ModAlt != 0 {
+		sb.WriteString("Alt+")
+	}
+	if b.Modifier&ModShift != 0 {
+		sb.WriteString("Shift+")
+	}
+	if b.Modifier&ModMeta != 0 {
+		sb.WriteString("Meta+")
+	}
+	switch {
+	case b.Key != KeyRune:
+		sb.WriteString(KeyName(b.Key))
+	case b.Rune == ' ':
+		sb.WriteString("Space")
+	case b.Modifier != ModNone:
+		sb.WriteString(strings.ToUpper(string(b.Rune)))
+	default:
+		sb.WriteRune(b.Rune)
+	}
+	return sb.String()
+}
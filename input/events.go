@@ -9,6 +9,8 @@ const (
 	EventMouse
 	EventError
 	EventQuit
+	EventUnknown
+	EventFocus
 )
 
 // Event is the interface for all events
@@ -21,6 +23,8 @@ type KeyEvent struct {
 	Key      Key
 	Rune     rune
 	Modifier Modifier
+	Keypad   bool // Sent by the numeric keypad in application mode
+	Repeat   bool // Same key as the last one, within the app's key repeat interval
 }
 
 func (e KeyEvent) Type() EventType {
@@ -76,11 +80,16 @@ const (
 )
 
 // MouseEvent represents a mouse event
+// X and Y are 0-indexed cell coordinates
 type MouseEvent struct {
 	X      int
 	Y      int
 	Button MouseButton
 	Mod    Modifier
+	Motion bool // The pointer moved with Button held, rather than a press
+	// DoubleClick marks a left press completing a double-click, detected
+	// by the app from the time and position of the previous press
+	DoubleClick bool
 }
 
 func (e MouseEvent) Type() EventType {
@@ -96,6 +105,27 @@ func (e ErrorEvent) Type() EventType {
 	return EventError
 }
 
+// UnknownSequenceEvent represents a complete escape sequence that isn't
+// recognized, such as a key the parser has no mapping for
+type UnknownSequenceEvent struct {
+	Raw []byte // The whole sequence, starting with ESC
+}
+
+func (e UnknownSequenceEvent) Type() EventType {
+	return EventUnknown
+}
+
+// FocusEvent reports the terminal window gaining or losing focus
+// Terminals only send it while focus reporting is on; see
+// terminal.EnableFocusReporting.
+type FocusEvent struct {
+	Focused bool
+}
+
+func (e FocusEvent) Type() EventType {
+	return EventFocus
+}
+
 // QuitEvent represents a quit signal
 type QuitEvent struct{}
 
@@ -15,10 +15,22 @@ type Reader struct {
 	escapeTime time.Duration
 }
 
+// Read error backoff: after a failed read the reader waits before trying
+// again, doubling the wait on each consecutive error up to the maximum
+const (
+	minReadBackoff = 10 * time.Millisecond
+	maxReadBackoff = time.Second
+)
+
 // NewReader creates a new input reader
 func NewReader() *Reader {
+	return NewReaderFrom(os.Stdin)
+}
+
+// NewReaderFrom creates an input reader reading from source instead of stdin
+func NewReaderFrom(source io.Reader) *Reader {
 	return &Reader{
-		reader:     os.Stdin,
+		reader:     source,
 		eventChan:  make(chan Event, 100),
 		stopChan:   make(chan struct{}),
 		buf:        make([]byte, 256),
@@ -42,7 +54,10 @@ func (r *Reader) Events() <-chan Event {
 }
 
 // readLoop continuously reads from stdin and parses input
+// Errors other than EOF are sent as ErrorEvents, and repeated errors back
+// off so a broken input doesn't spin
 func (r *Reader) readLoop() {
+	var backoff time.Duration
 	for {
 		select {
 		case <-r.stopChan:
@@ -51,10 +66,21 @@ func (r *Reader) readLoop() {
 			n, err := r.reader.Read(r.buf)
 			if err != nil {
 				if err != io.EOF {
-					r.eventChan <- ErrorEvent{Err: err}
+					select {
+					case r.eventChan <- ErrorEvent{Err: err}:
+					case <-r.stopChan:
+						return
+					}
+				}
+				backoff = min(max(2*backoff, minReadBackoff), maxReadBackoff)
+				select {
+				case <-time.After(backoff):
+				case <-r.stopChan:
+					return
 				}
 				continue
 			}
+			backoff = 0
 
 			r.parseInput(r.buf[:n])
 		}
@@ -151,6 +177,17 @@ func (r *Reader) parseCSI(data []byte) (Event, int) {
 
 	finalByte := data[i]
 	params := data[2:i]
+	if finalByte < 0x40 || finalByte > 0x7e {
+		return KeyEvent{Key: KeyEscape}, 1
+	}
+
+	// SGR mouse reports (ESC [ < b ; x ; y M/m)
+	if len(params) > 0 && params[0] == '<' && (finalByte == 'M' || finalByte == 'm') {
+		if event, ok := parseSGRMouse(params[1:], finalByte == 'm'); ok {
+			return event, i + 1
+		}
+		return nil, i + 1
+	}
 
 	switch finalByte {
 	case 'A':
@@ -166,9 +203,17 @@ func (r *Reader) parseCSI(data []byte) (Event, int) {
 	case 'F':
 		return KeyEvent{Key: KeyEnd, Modifier: parseCSIModifier(params)}, i + 1
 	case '~':
-		return r.parseTildeSequence(params), i + 1
+		if event := r.parseTildeSequence(params); event != nil {
+			return event, i + 1
+		}
+		return unknownSequence(data[:i+1]), i + 1
 	case 'Z':
 		return KeyEvent{Key: KeyTab, Modifier: ModShift}, i + 1
+	case 'I', 'O':
+		// Focus reports (ESC [ I / ESC [ O)
+		if len(params) == 0 {
+			return FocusEvent{Focused: finalByte == 'I'}, i + 1
+		}
 	}
 
 	// Function keys (some terminals)
@@ -176,10 +221,74 @@ func (r *Reader) parseCSI(data []byte) (Event, int) {
 		return KeyEvent{Key: Key(int(KeyF1) + int(finalByte-'P'))}, i + 1
 	}
 
-	return KeyEvent{Key: KeyEscape}, 1
+	return unknownSequence(data[:i+1]), i + 1
+}
+
+// unknownSequence returns an event for an unrecognized sequence, copying
+// it out of the read buffer
+func unknownSequence(seq []byte) UnknownSequenceEvent {
+	return UnknownSequenceEvent{Raw: append([]byte(nil), seq...)}
+}
+
+// parseSGRMouse parses the b;x;y parameters of an SGR mouse report
+// release is set for the lowercase 'm' final byte
+func parseSGRMouse(params []byte, release bool) (MouseEvent, bool) {
+	var nums [3]int
+	n := 0
+	for _, b := range params {
+		switch {
+		case b == ';':
+			n++
+			if n > 2 {
+				return MouseEvent{}, false
+			}
+		case b >= '0' && b <= '9':
+			nums[n] = nums[n]*10 + int(b-'0')
+		default:
+			return MouseEvent{}, false
+		}
+	}
+	if n != 2 || nums[1] < 1 || nums[2] < 1 {
+		return MouseEvent{}, false
+	}
+
+	code := nums[0]
+	event := MouseEvent{X: nums[1] - 1, Y: nums[2] - 1, Motion: code&32 != 0}
+	if code&4 != 0 {
+		event.Mod |= ModShift
+	}
+	if code&8 != 0 {
+		event.Mod |= ModAlt
+	}
+	if code&16 != 0 {
+		event.Mod |= ModCtrl
+	}
+
+	switch {
+	case release:
+		event.Button = MouseRelease
+	case code&64 != 0:
+		event.Button = MouseWheelUp
+		if code&1 != 0 {
+			event.Button = MouseWheelDown
+		}
+	default:
+		switch code & 3 {
+		case 0:
+			event.Button = MouseLeft
+		case 1:
+			event.Button = MouseMiddle
+		case 2:
+			event.Button = MouseRight
+		default:
+			event.Button = MouseNone // Motion with no button held
+		}
+	}
+	return event, true
 }
 
 // parseTildeSequence parses CSI n ~ sequences
+// Returns nil for an unknown n
 func (r *Reader) parseTildeSequence(params []byte) Event {
 	// Parse the number before the tilde
 	n := 0
@@ -250,41 +359,90 @@ func (r *Reader) parseTildeSequence(params []byte) Event {
 		return KeyEvent{Key: KeyF12, Modifier: mod}
 	}
 
-	return KeyEvent{Key: KeyEscape}
+	return nil
 }
 
 // parseSS3 parses SS3 (Single Shift 3) sequences
+// Some terminals put a modifier before the final byte, as ESC O 1 ; 2 P or
+// ESC O 2 P for Shift+F1
 func (r *Reader) parseSS3(data []byte) (Event, int) {
 	if len(data) < 3 {
 		return KeyEvent{Key: KeyEscape}, 1
 	}
 
-	switch data[2] {
+	i := 2
+	for i < len(data) && data[i] >= 0x30 && data[i] <= 0x3f {
+		i++
+	}
+	if i >= len(data) {
+		return KeyEvent{Key: KeyEscape}, 1
+	}
+	finalByte := data[i]
+	mod := parseSS3Modifier(data[2:i])
+
+	switch finalByte {
 	case 'A':
-		return KeyEvent{Key: KeyUp}, 3
+		return KeyEvent{Key: KeyUp, Modifier: mod}, i + 1
 	case 'B':
-		return KeyEvent{Key: KeyDown}, 3
+		return KeyEvent{Key: KeyDown, Modifier: mod}, i + 1
 	case 'C':
-		return KeyEvent{Key: KeyRight}, 3
+		return KeyEvent{Key: KeyRight, Modifier: mod}, i + 1
 	case 'D':
-		return KeyEvent{Key: KeyLeft}, 3
+		return KeyEvent{Key: KeyLeft, Modifier: mod}, i + 1
 	case 'H':
-		return KeyEvent{Key: KeyHome}, 3
+		return KeyEvent{Key: KeyHome, Modifier: mod}, i + 1
 	case 'F':
-		return KeyEvent{Key: KeyEnd}, 3
+		return KeyEvent{Key: KeyEnd, Modifier: mod}, i + 1
 	case 'P':
-		return KeyEvent{Key: KeyF1}, 3
+		return KeyEvent{Key: KeyF1, Modifier: mod}, i + 1
 	case 'Q':
-		return KeyEvent{Key: KeyF2}, 3
+		return KeyEvent{Key: KeyF2, Modifier: mod}, i + 1
 	case 'R':
-		return KeyEvent{Key: KeyF3}, 3
+		return KeyEvent{Key: KeyF3, Modifier: mod}, i + 1
 	case 'S':
-		return KeyEvent{Key: KeyF4}, 3
+		return KeyEvent{Key: KeyF4, Modifier: mod}, i + 1
+	case 'M':
+		return KeyEvent{Key: KeyEnter, Modifier: mod, Keypad: true}, i + 1
+	}
+	if r, ok := keypadRunes[finalByte]; ok {
+		return KeyEvent{Key: KeyRune, Rune: r, Modifier: mod, Keypad: true}, i + 1
 	}
 
+	// Any other final byte completes the sequence
+	if finalByte >= 0x40 && finalByte <= 0x7e {
+		return unknownSequence(data[:i+1]), i + 1
+	}
 	return KeyEvent{Key: KeyEscape}, 1
 }
 
+// keypadRunes maps the final bytes of SS3 sequences sent by the numeric
+// keypad in application mode to the characters on its keys
+var keypadRunes = map[byte]rune{
+	'j': '*', 'k': '+', 'l': ',', 'm': '-', 'n': '.', 'o': '/', 'X': '=',
+	'p': '0', 'q': '1', 'r': '2', 's': '3', 't': '4',
+	'u': '5', 'v': '6', 'w': '7', 'x': '8', 'y': '9',
+}
+
+// parseSS3Modifier parses the modifier of an SS3 sequence, given either as
+// a CSI-style 1;n or as n alone
+func parseSS3Modifier(params []byte) Modifier {
+	if len(params) == 0 {
+		return ModNone
+	}
+	for _, b := range params {
+		if b == ';' {
+			return parseCSIModifier(params)
+		}
+	}
+	n := 0
+	for _, b := range params {
+		if b >= '0' && b <= '9' {
+			n = n*10 + int(b-'0')
+		}
+	}
+	return decodeModifier(n)
+}
+
 // parseControl parses control characters
 func (r *Reader) parseControl(b byte) Event {
 	switch b {
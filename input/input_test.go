@@ -0,0 +1,150 @@
+package input
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failingReader fails every read, counting them
+type failingReader struct {
+	reads atomic.Int32
+}
+
+func (r *failingReader) Read([]byte) (int, error) {
+	r.reads.Add(1)
+	return 0, errors.New("broken")
+}
+
+func TestReaderSendsReadErrors(t *testing.T) {
+	source := &failingReader{}
+	r := NewReaderFrom(source)
+	r.Start()
+	defer r.Stop()
+
+	select {
+	case event := <-r.Events():
+		e, ok := event.(ErrorEvent)
+		if !ok || e.Err.Error() != "broken" {
+			t.Errorf("event = %#v, want ErrorEvent broken", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no ErrorEvent after a failed read")
+	}
+}
+
+func TestReaderBacksOffOnRepeatedErrors(t *testing.T) {
+	source := &failingReader{}
+	r := NewReaderFrom(source)
+	r.Start()
+	time.Sleep(150 * time.Millisecond)
+	r.Stop()
+
+	// Waits of 10, 20, 40 and 80ms allow about 4 reads in 150ms
+	if n := source.reads.Load(); n < 2 || n > 8 {
+		t.Errorf("reads in 150ms = %d, want between 2 and 8", n)
+	}
+}
+
+// parseAll parses data and returns the events it produced
+func parseAll(data string) []Event {
+	r := NewReaderFrom(nil)
+	r.parseInput([]byte(data))
+	var events []Event
+	for len(r.eventChan) > 0 {
+		events = append(events, <-r.eventChan)
+	}
+	return events
+}
+
+func TestParseUnknownSequence(t *testing.T) {
+	tests := []struct {
+		data string
+		raw  string
+	}{
+		{"\x1b[123;45z", "\x1b[123;45z"},
+		{"\x1b[99~", "\x1b[99~"},
+		{"\x1b[>1;2c", "\x1b[>1;2c"},
+		{"\x1bO1;2Z", "\x1bO1;2Z"},
+	}
+	for _, tt := range tests {
+		event, consumed := NewReaderFrom(nil).parseSequence([]byte(tt.data + "x"))
+		e, ok := event.(UnknownSequenceEvent)
+		if !ok || string(e.Raw) != tt.raw {
+			t.Errorf("parseSequence(%q) = %#v, want UnknownSequenceEvent %q", tt.data, event, tt.raw)
+		}
+		if consumed != len(tt.raw) {
+			t.Errorf("parseSequence(%q) consumed %d bytes, want %d", tt.data, consumed, len(tt.raw))
+		}
+	}
+}
+
+func TestUnknownSequenceDoesNotDesync(t *testing.T) {
+	events := parseAll("\x1b[123;45zab")
+	if len(events) != 3 {
+		t.Fatalf("got %d events %v, want 3", len(events), events)
+	}
+	if _, ok := events[0].(UnknownSequenceEvent); !ok {
+		t.Errorf("event 0 = %#v, want UnknownSequenceEvent", events[0])
+	}
+	for i, want := range "ab" {
+		if e, ok := events[i+1].(KeyEvent); !ok || e.Key != KeyRune || e.Rune != want {
+			t.Errorf("event %d = %#v, want %q", i+1, events[i+1], want)
+		}
+	}
+}
+
+func TestParseSS3Modifiers(t *testing.T) {
+	tests := []struct {
+		data string
+		key  Key
+		mod  Modifier
+	}{
+		{"\x1bOP", KeyF1, ModNone},
+		{"\x1bO1;2P", KeyF1, ModShift},
+		{"\x1bO2P", KeyF1, ModShift},
+		{"\x1bO1;5S", KeyF4, ModCtrl},
+		{"\x1bO1;3Q", KeyF2, ModAlt},
+		{"\x1bOA", KeyUp, ModNone},
+		{"\x1bO1;5A", KeyUp, ModCtrl},
+		{"\x1bO5D", KeyLeft, ModCtrl},
+		{"\x1bO1;6C", KeyRight, ModCtrl | ModShift},
+		{"\x1bO1;2F", KeyEnd, ModShift},
+	}
+	for _, tt := range tests {
+		event, consumed := NewReaderFrom(nil).parseSequence([]byte(tt.data))
+		e, ok := event.(KeyEvent)
+		if !ok || e.Key != tt.key || e.Modifier != tt.mod {
+			t.Errorf("parseSequence(%q) = %#v, want key %v with modifier %v", tt.data, event, tt.key, tt.mod)
+		}
+		if consumed != len(tt.data) {
+			t.Errorf("parseSequence(%q) consumed %d bytes, want %d", tt.data, consumed, len(tt.data))
+		}
+	}
+}
+
+func TestParseKeypad(t *testing.T) {
+	tests := []struct {
+		data string
+		want KeyEvent
+	}{
+		{"\x1bOM", KeyEvent{Key: KeyEnter, Keypad: true}},
+		{"\r", KeyEvent{Key: KeyEnter}},
+		{"\n", KeyEvent{Key: KeyEnter}},
+		{"\x1bOq", KeyEvent{Key: KeyRune, Rune: '1', Keypad: true}},
+		{"\x1bOk", KeyEvent{Key: KeyRune, Rune: '+', Keypad: true}},
+		{"\x1bO2M", KeyEvent{Key: KeyEnter, Modifier: ModShift, Keypad: true}},
+	}
+	for _, tt := range tests {
+		events := parseAll(tt.data)
+		if len(events) != 1 || events[0] != tt.want {
+			t.Errorf("parse(%q) = %#v, want %#v", tt.data, events, tt.want)
+		}
+	}
+
+	// Keypad Enter still matches plain Enter bindings
+	if !NewBinding(KeyEnter, ModNone).Matches(KeyEvent{Key: KeyEnter, Keypad: true}) {
+		t.Error("Enter binding does not match keypad Enter")
+	}
+}
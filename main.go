@@ -101,17 +101,7 @@ func NewDemoApp() *DemoApp {
 			{"5", "Eve", "Active"},
 		})
 	search := widget.NewSearch().SetPlaceholder("Search")
-	search.SetOnSubmit(func(value string) {
-		rows := d.table.Rows()
-		resultRows := [][]string{}
-		for _, row := range rows {
-			if strings.Contains(strings.ToLower(row[1]), strings.ToLower(value)) {
-				resultRows = append(resultRows, row)
-			}
-		}
-		searchResultsTable.SetRows(resultRows)
-	}).
-	SetHelpItems([]string{
+	search.SetHelpItems([]string{
 		"/items : Search items",
 		"/item-specs : Search item specifications",
 		"/storage : Search storage",
@@ -121,6 +111,15 @@ func NewDemoApp() *DemoApp {
 	d.searchAndResults = widget.NewSearchAndResults()
 	d.searchAndResults.SetSearch(search)
 	d.searchAndResults.SetTable(searchResultsTable)
+	d.searchAndResults.SetFilter(func(query string) [][]string {
+		resultRows := [][]string{}
+		for _, row := range d.table.Rows() {
+			if strings.Contains(strings.ToLower(row[1]), strings.ToLower(query)) {
+				resultRows = append(resultRows, row)
+			}
+		}
+		return resultRows
+	})
 
 	// Create progress widget
 	d.progress = widget.NewProgress().
@@ -137,7 +136,7 @@ func NewDemoApp() *DemoApp {
 		SetShowBorder(true).
 		SetLabelWidth(12)
 
-	d.textInput = d.form.AddTextInput("Username", "Enter username")
+	d.textInput = d.form.AddRequiredTextInput("Username", "Enter username")
 	d.form.AddPasswordInput("Password", "Enter password")
 	d.form.AddTextInput("Email", "user@example.com")
 
@@ -226,9 +225,9 @@ func (d *DemoApp) Render(buf *screen.Buffer, bounds layout.Rect) {
 	buf.DrawString(1, statusY, bounds.Z, d.statusText, statusStyle)
 
 	// Draw help on right side of status bar
-	help := "Ctrl+←/→: Switch tabs | Ctrl+Q: Quit"
+	help := "?: Help | Ctrl+←/→: Switch tabs | Ctrl+Q: Quit"
 	if d.currentTab == 5 {
-		help = "SPACE: Swap z-order | Ctrl+←/→: Switch tabs | Ctrl+Q: Quit"
+		help = "SPACE: Swap z-order | ?: Help | Ctrl+←/→: Switch tabs | Ctrl+Q: Quit"
 	}
 	buf.DrawString(bounds.Width-len(help)-1, statusY, bounds.Z, help, statusStyle)
 }
@@ -0,0 +1,58 @@
+package screen
+
+// Borders is a set of glyphs for drawing boxes and grid lines
+type Borders struct {
+	TopLeft     rune
+	TopRight    rune
+	BottomLeft  rune
+	BottomRight rune
+	Horizontal  rune
+	Vertical    rune
+	Cross       rune // Where a horizontal and vertical line meet
+}
+
+// UnicodeBorders draws with single-line box-drawing characters
+var UnicodeBorders = Borders{
+	TopLeft:     '┌',
+	TopRight:    '┐',
+	BottomLeft:  '└',
+	BottomRight: '┘',
+	Horizontal:  '─',
+	Vertical:    '│',
+	Cross:       '┼',
+}
+
+// AsciiBorders draws with plain ASCII, for minimal terminals and output
+// piped to a file
+var AsciiBorders = Borders{
+	TopLeft:     '+',
+	TopRight:    '+',
+	BottomLeft:  '+',
+	BottomRight: '+',
+	Horizontal:  '-',
+	Vertical:    '|',
+	Cross:       '+',
+}
+
+// asciiOnly is set when the terminal can't display box-drawing characters
+var asciiOnly bool
+
+// SetAsciiOnly sets whether boxes and grid lines are drawn with ASCII
+// instead of box-drawing characters by default
+func SetAsciiOnly(ascii bool) {
+	asciiOnly = ascii
+}
+
+// AsciiOnly returns whether boxes and grid lines are drawn with ASCII by
+// default
+func AsciiOnly() bool {
+	return asciiOnly
+}
+
+// DefaultBorders returns the border glyphs for the terminal's capability
+func DefaultBorders() Borders {
+	if asciiOnly {
+		return AsciiBorders
+	}
+	return UnicodeBorders
+}
@@ -1,6 +1,11 @@
 package screen
 
-import "github.com/agiles231/gotui/terminal"
+import (
+	"strings"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/terminal"
+)
 
 // Buffer represents a 3D grid of cells with z-ordering for layered rendering
 type Buffer struct {
@@ -8,6 +13,13 @@ type Buffer struct {
 	width  int
 	height int
 	depth  int
+	// Origin of this buffer within cells; non-zero for sub-views
+	originX int
+	originY int
+	// First writable column and row; non-zero for sub-views whose region
+	// starts above or left of their parent
+	minX int
+	minY int
 }
 
 // NewBuffer creates a new buffer with the specified dimensions
@@ -45,44 +57,82 @@ func (b *Buffer) Depth() int {
 	return b.depth
 }
 
+// inBounds checks if a position lies within the buffer
+func (b *Buffer) inBounds(x, y, z int) bool {
+	return x >= b.minX && x < b.width && y >= b.minY && y < b.height && z >= 0 && z < b.depth
+}
+
+// cell returns a pointer to the cell at a position already checked with inBounds
+func (b *Buffer) cell(x, y, z int) *Cell {
+	return &b.cells[z][b.originY+y][b.originX+x]
+}
+
 // Get returns the cell at the given position
 func (b *Buffer) Get(x, y, z int) Cell {
-	if x < 0 || x >= b.width || y < 0 || y >= b.height || z < 0 || z >= b.depth {
+	if !b.inBounds(x, y, z) {
 		return EmptyCell()
 	}
-	return b.cells[z][y][x]
+	return *b.cell(x, y, z)
 }
 
 // Set sets the cell at the given position
 func (b *Buffer) Set(x, y, z int, cell Cell) {
-	if x < 0 || x >= b.width || y < 0 || y >= b.height || z < 0 || z >= b.depth {
+	if !b.inBounds(x, y, z) {
 		return
 	}
-	b.cells[z][y][x] = cell
+	*b.cell(x, y, z) = cell
 }
 
 // SetRune sets just the rune at the given position
 func (b *Buffer) SetRune(x, y, z int, r rune) {
-	if x < 0 || x >= b.width || y < 0 || y >= b.height || z < 0 || z >= b.depth {
+	if !b.inBounds(x, y, z) {
 		return
 	}
-	b.cells[z][y][x].Rune = r
+	b.cell(x, y, z).Rune = r
 }
 
 // SetStyle sets just the style at the given position
 func (b *Buffer) SetStyle(x, y, z int, style terminal.Style) {
-	if x < 0 || x >= b.width || y < 0 || y >= b.height || z < 0 || z >= b.depth {
+	if !b.inBounds(x, y, z) {
 		return
 	}
-	b.cells[z][y][x].Style = style
+	b.cell(x, y, z).Style = style
+}
+
+// SubView returns a view of the region rect backed by the same cells
+// Coordinates in the view are relative to the region's top-left corner and
+// writes falling outside the region are dropped, so a widget drawing into
+// the view can't corrupt its neighbors. Writes to the parts of the region
+// outside the buffer are dropped too. Z is not translated.
+func (b *Buffer) SubView(rect layout.Rect) *Buffer {
+	view := &Buffer{
+		cells:   b.cells,
+		depth:   b.depth,
+		originX: b.originX + rect.X,
+		originY: b.originY + rect.Y,
+	}
+	clipped := rect.Intersection(layout.NewRect(b.minX, b.minY, 0, b.width-b.minX, b.height-b.minY))
+	if clipped.IsEmpty() {
+		return view
+	}
+	view.minX, view.minY = clipped.X-rect.X, clipped.Y-rect.Y
+	view.width, view.height = clipped.Right()-rect.X, clipped.Bottom()-rect.Y
+	return view
+}
+
+// Clip returns a view that drops writes outside rect, backed by the same
+// cells. Unlike SubView, coordinates are not translated, so a child drawing
+// into the view records the bounds it is actually drawn at.
+func (b *Buffer) Clip(rect layout.Rect) *Buffer {
+	return b.SubView(rect).SubView(layout.NewRect(-rect.X, -rect.Y, 0, b.width, b.height))
 }
 
 // Fill fills the entire buffer with the given cell (all z-layers)
 func (b *Buffer) Fill(cell Cell) {
-	for z := range b.cells {
-		for y := range b.cells[z] {
-			for x := range b.cells[z][y] {
-				b.cells[z][y][x] = cell
+	for z := 0; z < b.depth; z++ {
+		for y := b.minY; y < b.height; y++ {
+			for x := b.minX; x < b.width; x++ {
+				*b.cell(x, y, z) = cell
 			}
 		}
 	}
@@ -103,25 +153,81 @@ func (b *Buffer) FillRect(x, y, z, width, height int, cell Cell) {
 }
 
 // DrawString draws a string at the given position with the given style
+// Wide runes take two cells and zero-width runes none, matching
+// DisplayWidth.
 func (b *Buffer) DrawString(x, y, z int, s string, style terminal.Style) {
-	// TODO: handle multi rune characters
-	for i, r := range s {
-		b.Set(x+i, y, z, NewCell(r, style))
-	}
+	b.drawRunes(x, y, z, s, DisplayWidth(s), func(int) Cell { return NewCell(0, style) })
 }
 
-// DrawStringClipped draws a string clipped to a maximum width
+// DrawLink draws text as a hyperlink to url, which terminals supporting
+// OSC 8 let the user open
+func (b *Buffer) DrawLink(x, y, z int, text, url string, style terminal.Style) {
+	cell := NewCell(0, style)
+	cell.Link = url
+	b.drawRunes(x, y, z, text, DisplayWidth(text), func(int) Cell { return cell })
+}
+
+// DrawStringClipped draws a string clipped to a maximum width in cells
+// A wide rune that would straddle the limit is left out.
 func (b *Buffer) DrawStringClipped(x, y, z int, s string, style terminal.Style, maxWidth int) {
-	i := 0
+	b.drawRunes(x, y, z, s, maxWidth, func(int) Cell { return NewCell(0, style) })
+}
+
+// DrawStringHighlighted draws a string clipped to a maximum width, using
+// highlight for the runes at the given rune indexes
+func (b *Buffer) DrawStringHighlighted(x, y, z int, s string, style, highlight terminal.Style, positions []int, maxWidth int) {
+	next := 0
+	b.drawRunes(x, y, z, s, maxWidth, func(i int) Cell {
+		for next < len(positions) && positions[next] < i {
+			next++
+		}
+		if next < len(positions) && positions[next] == i {
+			return NewCell(0, highlight)
+		}
+		return NewCell(0, style)
+	})
+}
+
+// drawRunes draws the runes of s from x, at most maxWidth cells wide
+// cellFor gives the style and link of the rune at an index as a cell
+// without a rune, which is also what fills the continuation cell right of
+// a wide rune.
+func (b *Buffer) drawRunes(x, y, z int, s string, maxWidth int, cellFor func(i int) Cell) {
+	col, i := 0, 0
 	for _, r := range s {
-		if i >= maxWidth {
+		w := RuneWidth(r)
+		if col+w > maxWidth {
 			break
 		}
-		b.Set(x+i, y, z, NewCell(r, style))
+		if w > 0 {
+			cell := cellFor(i)
+			b.Set(x+col, y, z, cell.WithRune(r))
+			if w == 2 {
+				b.Set(x+col+1, y, z, cell)
+			}
+		}
+		col += w
 		i++
 	}
 }
 
+// DrawImageHalfBlocks draws pixels, indexed by [row][column], two rows per
+// cell using upper half blocks: the top pixel colors the foreground and the
+// bottom pixel the background
+// The bottom half of the last cells of an odd-height image is left in the
+// default background.
+func (b *Buffer) DrawImageHalfBlocks(x, y, z int, pixels [][]terminal.RGB) {
+	for row := 0; row < len(pixels); row += 2 {
+		for col, top := range pixels[row] {
+			style := terminal.DefaultStyle().WithFG(top)
+			if row+1 < len(pixels) && col < len(pixels[row+1]) {
+				style = style.WithBG(pixels[row+1][col])
+			}
+			b.Set(x+col, y+row/2, z, NewCell('▀', style))
+		}
+	}
+}
+
 // DrawHLine draws a horizontal line
 func (b *Buffer) DrawHLine(x, y, z, width int, r rune, style terminal.Style) {
 	cell := NewCell(r, style)
@@ -138,19 +244,24 @@ func (b *Buffer) DrawVLine(x, y, z, height int, r rune, style terminal.Style) {
 	}
 }
 
-// DrawBox draws a box with the specified box-drawing characters
+// DrawBox draws a box with the default border characters
 func (b *Buffer) DrawBox(x, y, z, width, height int, style terminal.Style) {
+	b.DrawBorderedBox(x, y, z, width, height, DefaultBorders(), style)
+}
+
+// DrawBorderedBox draws a box with the given border characters
+func (b *Buffer) DrawBorderedBox(x, y, z, width, height int, borders Borders, style terminal.Style) {
 	if width < 2 || height < 2 {
 		return
 	}
 
 	// Box drawing characters
-	topLeft := '┌'
-	topRight := '┐'
-	bottomLeft := '└'
-	bottomRight := '┘'
-	horizontal := '─'
-	vertical := '│'
+	topLeft := borders.TopLeft
+	topRight := borders.TopRight
+	bottomLeft := borders.BottomLeft
+	bottomRight := borders.BottomRight
+	horizontal := borders.Horizontal
+	vertical := borders.Vertical
 
 	// Top border
 	b.Set(x, y, z, NewCell(topLeft, style))
@@ -207,16 +318,33 @@ func (b *Buffer) Flatten() [][]Cell {
 			result[y][x] = EmptyCell()
 			// Composite from z=0 to z=depth-1
 			for z := 0; z < b.depth; z++ {
-				cell := b.cells[z][y][x]
+				cell := b.Get(x, y, z)
 				if !cell.IsEmpty() {
 					result[y][x] = cell
 				}
 			}
 		}
+		repairWide(result[y])
 	}
 	return result
 }
 
+// repairWide blanks the halves of wide runes split by overlapping draws,
+// so every wide rune in row is followed by a continuation cell and every
+// continuation cell follows a wide rune
+func repairWide(row []Cell) {
+	for x := range row {
+		if row[x].IsContinuation() && (x == 0 || RuneWidth(row[x-1].Rune) != 2) {
+			row[x].Rune = ' '
+		}
+	}
+	for x := range row {
+		if RuneWidth(row[x].Rune) == 2 && (x+1 == len(row) || !row[x+1].IsContinuation()) {
+			row[x].Rune = ' '
+		}
+	}
+}
+
 // Resize creates a new buffer with the given dimensions, copying existing content
 func (b *Buffer) Resize(width, height, depth int) *Buffer {
 	newBuf := NewBuffer(width, height, depth)
@@ -225,7 +353,7 @@ func (b *Buffer) Resize(width, height, depth int) *Buffer {
 	for z := 0; z < min(b.depth, depth); z++ {
 		for y := 0; y < min(b.height, height); y++ {
 			for x := 0; x < min(b.width, width); x++ {
-				newBuf.cells[z][y][x] = b.cells[z][y][x]
+				newBuf.cells[z][y][x] = b.Get(x, y, z)
 			}
 		}
 	}
@@ -236,9 +364,10 @@ func (b *Buffer) Resize(width, height, depth int) *Buffer {
 // Clone creates a deep copy of the buffer
 func (b *Buffer) Clone() *Buffer {
 	clone := NewBuffer(b.width, b.height, b.depth)
-	for z := range b.cells {
-		for y := range b.cells[z] {
-			copy(clone.cells[z][y], b.cells[z][y])
+	for z := 0; z < b.depth; z++ {
+		for y := b.minY; y < b.height; y++ {
+			row := b.cells[z][b.originY+y]
+			copy(clone.cells[z][y][b.minX:], row[b.originX+b.minX:b.originX+b.width])
 		}
 	}
 	return clone
@@ -269,3 +398,88 @@ func (b *Buffer) BlitBuffer(src *Buffer, dstX, dstY, dstZ int) {
 		}
 	}
 }
+
+// ToString flattens the buffer into plain text, one line per row
+// Trailing spaces are trimmed from each line so snapshots stay readable
+func (b *Buffer) ToString() string {
+	flattened := b.Flatten()
+	lines := make([]string, len(flattened))
+	for y, row := range flattened {
+		var sb strings.Builder
+		for _, cell := range row {
+			if !cell.IsContinuation() {
+				sb.WriteRune(cell.Rune)
+			}
+		}
+		lines[y] = strings.TrimRight(sb.String(), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ToStyledString flattens the buffer into text annotated with ANSI style
+// sequences, emitting a sequence whenever the style changes
+// Each line ends with a style reset
+func (b *Buffer) ToStyledString() string {
+	flattened := b.Flatten()
+	lines := make([]string, len(flattened))
+	for y, row := range flattened {
+		var sb strings.Builder
+		var lastStyle terminal.Style
+		styleSet := false
+		link := ""
+		for _, cell := range row {
+			sb.WriteString(switchLink(link, cell.Link))
+			link = cell.Link
+			if !styleSet || !cell.Style.Equals(lastStyle) {
+				sb.WriteString(cell.Style.Sequence())
+				lastStyle = cell.Style
+				styleSet = true
+			}
+			if !cell.IsContinuation() {
+				sb.WriteRune(cell.Rune)
+			}
+		}
+		sb.WriteString(switchLink(link, ""))
+		sb.WriteString(terminal.StyleReset)
+		lines[y] = sb.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CellDiff describes a cell that differs between two buffers
+type CellDiff struct {
+	X     int
+	Y     int
+	Got   Cell // Cell in the receiver
+	Other Cell // Cell in the other buffer
+}
+
+// Diff compares the flattened contents of two buffers and returns every
+// position where they differ. Positions outside one of the buffers compare
+// against an empty cell.
+func (b *Buffer) Diff(other *Buffer) []CellDiff {
+	width := max(b.width, other.width)
+	height := max(b.height, other.height)
+	mine := b.Flatten()
+	theirs := other.Flatten()
+
+	var diffs []CellDiff
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			got := flattenedAt(mine, x, y)
+			want := flattenedAt(theirs, x, y)
+			if !got.Equals(want) {
+				diffs = append(diffs, CellDiff{X: x, Y: y, Got: got, Other: want})
+			}
+		}
+	}
+	return diffs
+}
+
+// flattenedAt returns the cell at (x, y) in a flattened buffer, or an empty cell
+func flattenedAt(cells [][]Cell, x, y int) Cell {
+	if y < 0 || y >= len(cells) || x < 0 || x >= len(cells[y]) {
+		return EmptyCell()
+	}
+	return cells[y][x]
+}
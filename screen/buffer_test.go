@@ -0,0 +1,166 @@
+package screen
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestBufferToStyledString(t *testing.T) {
+	bold := terminal.DefaultStyle().WithBold()
+	buf := NewBuffer(3, 1, 1)
+	buf.DrawString(0, 0, 0, "ab", bold)
+
+	want := bold.Sequence() + "ab" + EmptyCell().Style.Sequence() + " " + terminal.StyleReset
+	if got := buf.ToStyledString(); got != want {
+		t.Errorf("ToStyledString() = %q, want %q", got, want)
+	}
+	if got := buf.ToString(); got != "ab" {
+		t.Errorf("ToString() = %q, want %q", got, "ab")
+	}
+}
+
+func TestBufferDiff(t *testing.T) {
+	plain := terminal.DefaultStyle()
+	bold := plain.WithBold()
+	a := NewBuffer(3, 2, 1)
+	a.DrawString(0, 0, 0, "abc", plain)
+	a.DrawString(0, 1, 0, "d", plain)
+	b := NewBuffer(3, 2, 1)
+	b.DrawString(0, 0, 0, "axc", plain) // Rune differs
+	b.DrawString(0, 1, 0, "d", bold)    // Style differs
+
+	if diffs := a.Diff(a); len(diffs) != 0 {
+		t.Errorf("buffer differs from itself: %v", diffs)
+	}
+	checkDiffs(t, "same size", a.Diff(b), []CellDiff{
+		{X: 1, Y: 0, Got: NewCell('b', plain), Other: NewCell('x', plain)},
+		{X: 0, Y: 1, Got: NewCell('d', plain), Other: NewCell('d', bold)},
+	})
+
+	// Cells outside the smaller buffer compare against an empty cell
+	small := NewBuffer(2, 1, 1)
+	small.DrawString(0, 0, 0, "ab", plain)
+	checkDiffs(t, "larger receiver", a.Diff(small), []CellDiff{
+		{X: 2, Y: 0, Got: NewCell('c', plain), Other: EmptyCell()},
+		{X: 0, Y: 1, Got: NewCell('d', plain), Other: EmptyCell()},
+	})
+	checkDiffs(t, "smaller receiver", small.Diff(a), []CellDiff{
+		{X: 2, Y: 0, Got: EmptyCell(), Other: NewCell('c', plain)},
+		{X: 0, Y: 1, Got: EmptyCell(), Other: NewCell('d', plain)},
+	})
+}
+
+// checkDiffs reports where got differs from want
+func checkDiffs(t *testing.T, name string, got, want []CellDiff) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s: got %d diffs %v, want %v", name, len(got), got, want)
+		return
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.X != w.X || g.Y != w.Y || !g.Got.Equals(w.Got) || !g.Other.Equals(w.Other) {
+			t.Errorf("%s: diff %d = %+v, want %+v", name, i, g, w)
+		}
+	}
+}
+
+func TestSubViewTranslatesAndClips(t *testing.T) {
+	buf := NewBuffer(10, 5, 1)
+	view := buf.SubView(layout.NewRect(2, 1, 0, 3, 2))
+	style := terminal.DefaultStyle()
+
+	view.Set(0, 0, 0, NewCell('a', style))
+	view.Set(2, 1, 0, NewCell('b', style))
+	if got := buf.Get(2, 1, 0).Rune; got != 'a' {
+		t.Errorf("(0, 0) in the view landed as %q at (2, 1), want 'a'", got)
+	}
+	if got := buf.Get(4, 2, 0).Rune; got != 'b' {
+		t.Errorf("(2, 1) in the view landed as %q at (4, 2), want 'b'", got)
+	}
+
+	// Writes outside the view are dropped
+	view.Set(3, 0, 0, NewCell('x', style))
+	view.Set(0, 2, 0, NewCell('x', style))
+	view.Set(-1, 0, 0, NewCell('x', style))
+	view.DrawString(0, 1, 0, "cdefg", style)
+	if got := buf.ToString(); got != "\n  a\n  cde\n\n" {
+		t.Errorf("buffer =\n%q, want writes outside the view dropped", got)
+	}
+}
+
+func TestSubViewOffscreenKeepsOrigin(t *testing.T) {
+	buf := NewBuffer(5, 5, 1)
+	// A view hanging off the top-left keeps its coordinates: (2, 2) in the
+	// view is (0, 0) in the buffer
+	view := buf.SubView(layout.NewRect(-2, -2, 0, 4, 4))
+	view.Set(1, 1, 0, NewCell('x', terminal.DefaultStyle()))
+	view.Set(2, 2, 0, NewCell('a', terminal.DefaultStyle()))
+	if got := buf.ToString(); got != "a\n\n\n\n" {
+		t.Errorf("buffer =\n%q, want only 'a' at (0, 0)", got)
+	}
+}
+
+func TestClipKeepsCoordinates(t *testing.T) {
+	buf := NewBuffer(6, 4, 1)
+	view := buf.Clip(layout.NewRect(1, 1, 0, 3, 2))
+	view.DrawString(0, 1, 0, "abcdef", terminal.DefaultStyle())
+	view.Set(2, 2, 0, NewCell('x', terminal.DefaultStyle()))
+	view.Set(2, 3, 0, NewCell('y', terminal.DefaultStyle()))
+	if got := buf.ToString(); got != "\n bcd\n  x\n" {
+		t.Errorf("buffer =\n%q, want only writes within (1, 1)-(4, 3) at their own coordinates", got)
+	}
+
+	// Clipping a clipped view keeps the overlap
+	inner := view.Clip(layout.NewRect(3, 0, 0, 3, 4))
+	inner.DrawString(0, 2, 0, "123456", terminal.DefaultStyle())
+	if got := buf.ToString(); got != "\n bcd\n  x4\n" {
+		t.Errorf("buffer =\n%q, want only (3, 2) written", got)
+	}
+}
+
+func TestDrawBoxAsciiOnly(t *testing.T) {
+	SetAsciiOnly(true)
+	t.Cleanup(func() { SetAsciiOnly(false) })
+	buf := NewBuffer(4, 3, 1)
+	buf.DrawBox(0, 0, 0, 4, 3, terminal.DefaultStyle())
+	if got, want := buf.ToString(), "+--+\n|  |\n+--+"; got != want {
+		t.Errorf("DrawBox drew\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDrawImageHalfBlocks(t *testing.T) {
+	red, green := terminal.NewRGB(255, 0, 0), terminal.NewRGB(0, 255, 0)
+	blue, white := terminal.NewRGB(0, 0, 255), terminal.NewRGB(255, 255, 255)
+	pixels := [][]terminal.RGB{
+		{red, green},
+		{blue, white},
+		{green, red}, // Odd row, drawn with the default background
+	}
+	buf := NewBuffer(3, 3, 1)
+	buf.DrawImageHalfBlocks(1, 0, 0, pixels)
+
+	tests := []struct {
+		x, y   int
+		fg, bg terminal.Color
+	}{
+		{1, 0, red, blue},
+		{2, 0, green, white},
+		{1, 1, green, terminal.DefaultStyle().BG},
+		{2, 1, red, terminal.DefaultStyle().BG},
+	}
+	for _, tt := range tests {
+		cell := buf.Get(tt.x, tt.y, 0)
+		if cell.Rune != '▀' {
+			t.Errorf("cell (%d, %d) = %q, want '▀'", tt.x, tt.y, cell.Rune)
+		}
+		if cell.Style.FG != tt.fg || cell.Style.BG != tt.bg {
+			t.Errorf("cell (%d, %d) colors = %v on %v, want %v on %v", tt.x, tt.y, cell.Style.FG, cell.Style.BG, tt.fg, tt.bg)
+		}
+	}
+	if got := buf.ToString(); got != " ▀▀\n ▀▀\n" {
+		t.Errorf("buffer = %q, want the image in 2 rows", got)
+	}
+}
@@ -6,6 +6,7 @@ import "github.com/agiles231/gotui/terminal"
 type Cell struct {
 	Rune  rune
 	Style terminal.Style
+	Link  string // URL the cell links to, or "" for none
 }
 
 // NewCell creates a new cell with the given rune and style
@@ -16,6 +17,19 @@ func NewCell(r rune, style terminal.Style) Cell {
 	}
 }
 
+// ContinuationCell returns the cell covered by the right half of a wide
+// rune drawn in the cell to its left
+// Screens don't write continuation cells; the terminal fills them when it
+// draws the wide rune.
+func ContinuationCell(style terminal.Style) Cell {
+	return Cell{Style: style}
+}
+
+// IsContinuation returns true if the cell is the right half of a wide rune
+func (c Cell) IsContinuation() bool {
+	return c.Rune == 0
+}
+
 // EmptyCell returns an empty cell with default style
 func EmptyCell() Cell {
 	return Cell{
@@ -26,12 +40,12 @@ func EmptyCell() Cell {
 
 // Equals checks if two cells are identical
 func (c Cell) Equals(other Cell) bool {
-	return c.Rune == other.Rune && c.Style.Equals(other.Style)
+	return c.Rune == other.Rune && c.Style.Equals(other.Style) && c.Link == other.Link
 }
 
 // IsEmpty returns true if the cell is a space with default style
 func (c Cell) IsEmpty() bool {
-	return c.Rune == ' ' && c.Style.Equals(terminal.DefaultStyle())
+	return c.Rune == ' ' && c.Style.Equals(terminal.DefaultStyle()) && c.Link == ""
 }
 
 // WithRune returns a copy of the cell with a different rune
@@ -1,7 +1,8 @@
 package screen
 
 import (
-	"fmt"
+	"bufio"
+	"io"
 	"os"
 	"strings"
 
@@ -11,15 +12,37 @@ import (
 // DefaultDepth is the default number of z-layers for the buffer
 const DefaultDepth = 10
 
+// outputBufferSize is the size of the output buffer, large enough to hold
+// a typical full frame so it reaches the terminal in a single write
+const outputBufferSize = 64 * 1024
+
 // Screen manages terminal rendering with double-buffering
 type Screen struct {
-	terminal   *terminal.Terminal
-	front      [][]Cell // Flattened 2D for comparison (what's currently displayed)
-	back       *Buffer  // 3D buffer we're drawing to
-	width      int
-	height     int
-	depth      int
-	output     *strings.Builder
+	terminal    *terminal.Terminal
+	front       [][]Cell // Flattened 2D for comparison (what's currently displayed)
+	back        *Buffer  // 3D buffer we're drawing to
+	width       int
+	height      int
+	depth       int
+	writer      io.Writer     // Output target (defaults to stdout)
+	output      *bufio.Writer // All terminal output goes through here
+	counter     *countingWriter
+	changed     int  // Cells written by the last Render or ForceRender
+	inline      bool // Drawing below the saved cursor position, not over the whole terminal
+	dim         bool // Every cell is drawn dimmed
+	blinkHidden bool // Cells with app-blink styles are drawn blank
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
 }
 
 // NewScreen creates a new screen instance
@@ -33,7 +56,27 @@ func NewScreenWithDepth(term *terminal.Terminal, depth int) (*Screen, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newScreen(term, width, height, depth, os.Stdout), nil
+}
+
+// NewScreenWithWriter creates a new screen instance that writes its output to w
+// instead of stdout, e.g. to capture rendered frames or record a session
+func NewScreenWithWriter(term *terminal.Terminal, w io.Writer) (*Screen, error) {
+	width, height, err := term.Size()
+	if err != nil {
+		return nil, err
+	}
+	return newScreen(term, width, height, DefaultDepth, w), nil
+}
+
+// NewScreenSize creates a screen of a fixed size that writes to w without
+// querying a terminal, for tests and headless rendering
+func NewScreenSize(width, height int, w io.Writer) *Screen {
+	return newScreen(nil, width, height, DefaultDepth, w)
+}
 
+// newScreen creates a screen with the given dimensions and output target
+func newScreen(term *terminal.Terminal, width, height, depth int, w io.Writer) *Screen {
 	// Create initial front buffer (2D flattened)
 	front := make([][]Cell, height)
 	for y := 0; y < height; y++ {
@@ -43,6 +86,7 @@ func NewScreenWithDepth(term *terminal.Terminal, depth int) (*Screen, error) {
 		}
 	}
 
+	counter := &countingWriter{w: w}
 	return &Screen{
 		terminal: term,
 		front:    front,
@@ -50,8 +94,10 @@ func NewScreenWithDepth(term *terminal.Terminal, depth int) (*Screen, error) {
 		width:    width,
 		height:   height,
 		depth:    depth,
-		output:   &strings.Builder{},
-	}, nil
+		writer:   w,
+		output:   bufio.NewWriterSize(counter, outputBufferSize),
+		counter:  counter,
+	}
 }
 
 // Width returns the screen width
@@ -69,6 +115,22 @@ func (s *Screen) Depth() int {
 	return s.depth
 }
 
+// Writer returns the output target of the screen
+func (s *Screen) Writer() io.Writer {
+	return s.writer
+}
+
+// BytesWritten returns the total number of bytes flushed to the output
+func (s *Screen) BytesWritten() int64 {
+	return s.counter.n
+}
+
+// ChangedCells returns the number of cells written by the last Render,
+// or every cell after ForceRender
+func (s *Screen) ChangedCells() int {
+	return s.changed
+}
+
 // Buffer returns the back buffer for drawing
 func (s *Screen) Buffer() *Buffer {
 	return s.back
@@ -76,20 +138,25 @@ func (s *Screen) Buffer() *Buffer {
 
 // Resize resizes the screen buffers
 func (s *Screen) Resize(width, height int) {
-	s.width = width
-	s.height = height
-
-	// Create new front buffer (2D)
-	s.front = make([][]Cell, height)
+	// Keep the front buffer's overlapping content as the diff baseline, so
+	// the next render only repaints what actually differs
+	front := make([][]Cell, height)
 	for y := 0; y < height; y++ {
-		s.front[y] = make([]Cell, width)
+		front[y] = make([]Cell, width)
 		for x := 0; x < width; x++ {
-			s.front[y][x] = EmptyCell()
+			if y < s.height && x < s.width {
+				front[y][x] = s.front[y][x]
+			} else {
+				front[y][x] = EmptyCell()
+			}
 		}
 	}
+	s.front = front
 
-	// Create new back buffer (3D)
-	s.back = NewBuffer(width, height, s.depth)
+	// Resize the back buffer, preserving its content
+	s.back = s.back.Resize(width, height, s.depth)
+	s.width = width
+	s.height = height
 }
 
 // Clear clears the back buffer
@@ -97,31 +164,93 @@ func (s *Screen) Clear() {
 	s.back.Clear()
 }
 
-// Render renders the back buffer to the terminal using diff-based updates
-func (s *Screen) Render() {
-	s.output.Reset()
+// SetDim sets whether every cell is drawn dimmed, e.g. while the terminal
+// is unfocused
+// The cells are dimmed as they are written, leaving the back buffer as
+// drawn, so the next Render redraws them all.
+func (s *Screen) SetDim(dim bool) {
+	s.dim = dim
+}
 
-	// Flatten the 3D back buffer to 2D for comparison
+// Dim returns whether every cell is drawn dimmed
+func (s *Screen) Dim() bool {
+	return s.dim
+}
+
+// SetBlinkHidden sets whether cells with an app-blink style are drawn
+// blank, for the hidden phase of the blink
+// See terminal.Style.WithAppBlink.
+func (s *Screen) SetBlinkHidden(hidden bool) {
+	s.blinkHidden = hidden
+}
+
+// flatten flattens the back buffer for output, dimming it and hiding
+// blinking cells if set
+func (s *Screen) flatten() [][]Cell {
 	flattened := s.back.Flatten()
+	if !s.dim && !s.blinkHidden {
+		return flattened
+	}
+	for _, row := range flattened {
+		for x := range row {
+			if s.blinkHidden && row[x].Style.AppBlink {
+				row[x].Rune = ' '
+			}
+			if s.dim {
+				row[x].Style = row[x].Style.WithDim()
+			}
+		}
+	}
+	return flattened
+}
+
+// switchLink returns the sequences ending the open hyperlink and starting
+// url, or "" if they are the same
+func switchLink(open, url string) string {
+	if open == url {
+		return ""
+	}
+	seq := ""
+	if open != "" {
+		seq = terminal.Hyperlink("")
+	}
+	if url != "" {
+		seq += terminal.Hyperlink(url)
+	}
+	return seq
+}
+
+// Render writes the changed cells of the back buffer to the output buffer
+// using diff-based updates. Call Flush to send the frame to the terminal.
+func (s *Screen) Render() {
+	// Flatten the 3D back buffer to 2D for comparison
+	flattened := s.flatten()
 
 	var lastStyle terminal.Style
 	styleSet := false
+	link := "" // Open hyperlink, ended before moving the cursor
 	lastX, lastY := -1, -1
+	s.changed = 0
 
 	for y := 0; y < s.height; y++ {
 		for x := 0; x < s.width; x++ {
 			backCell := flattened[y][x]
 			frontCell := s.front[y][x]
 
-			// Skip if cell hasn't changed
-			if backCell.Equals(frontCell) {
+			// Skip if cell hasn't changed, or is covered by the wide rune
+			// left of it
+			if backCell.Equals(frontCell) || backCell.IsContinuation() {
 				continue
 			}
 
 			// Move cursor if not consecutive
 			if x != lastX+1 || y != lastY {
-				s.output.WriteString(terminal.CursorMove(x+1, y+1))
+				s.output.WriteString(switchLink(link, ""))
+				link = ""
+				s.output.WriteString(s.cursorTo(x, y))
 			}
+			s.output.WriteString(switchLink(link, backCell.Link))
+			link = backCell.Link
 
 			// Update style if changed
 			if !styleSet || !backCell.Style.Equals(lastStyle) {
@@ -130,46 +259,47 @@ func (s *Screen) Render() {
 				styleSet = true
 			}
 
-			// Write the character
+			// Write the character; a wide one moves the cursor two cells
 			s.output.WriteRune(backCell.Rune)
+			s.changed++
 
-			lastX = x
+			lastX = x + max(0, RuneWidth(backCell.Rune)-1)
 			lastY = y
 		}
 	}
 
-	// Reset style at end
+	// End any link and reset style at end
+	s.output.WriteString(switchLink(link, ""))
 	if styleSet {
 		s.output.WriteString(terminal.StyleReset)
 	}
 
-	// Write to terminal
-	if s.output.Len() > 0 {
-		os.Stdout.WriteString(s.output.String())
-	}
-
 	// Copy flattened to front
 	for y := 0; y < s.height; y++ {
 		copy(s.front[y], flattened[y])
 	}
 }
 
-// ForceRender renders the entire back buffer regardless of changes
+// ForceRender writes the entire back buffer to the output buffer regardless
+// of changes. Call Flush to send the frame to the terminal.
 func (s *Screen) ForceRender() {
-	s.output.Reset()
-
 	// Flatten the 3D back buffer to 2D
-	flattened := s.back.Flatten()
+	flattened := s.flatten()
 
 	var lastStyle terminal.Style
 	styleSet := false
 
+	link := ""
+
 	// Move to home
-	s.output.WriteString(terminal.CursorHome)
+	s.output.WriteString(s.cursorTo(0, 0))
+	s.changed = s.width * s.height
 
 	for y := 0; y < s.height; y++ {
 		for x := 0; x < s.width; x++ {
 			cell := flattened[y][x]
+			s.output.WriteString(switchLink(link, cell.Link))
+			link = cell.Link
 
 			// Update style if changed
 			if !styleSet || !cell.Style.Equals(lastStyle) {
@@ -178,10 +308,14 @@ func (s *Screen) ForceRender() {
 				styleSet = true
 			}
 
-			s.output.WriteRune(cell.Rune)
+			if !cell.IsContinuation() {
+				s.output.WriteRune(cell.Rune)
+			}
 		}
 
-		// Don't add newline on last row
+		// End the row's link and don't add newline on last row
+		s.output.WriteString(switchLink(link, ""))
+		link = ""
 		if y < s.height-1 {
 			s.output.WriteString("\r\n")
 		}
@@ -190,18 +324,58 @@ func (s *Screen) ForceRender() {
 	// Reset style at end
 	s.output.WriteString(terminal.StyleReset)
 
-	// Write to terminal
-	os.Stdout.WriteString(s.output.String())
-
 	// Copy flattened to front
 	for y := 0; y < s.height; y++ {
 		copy(s.front[y], flattened[y])
 	}
 }
 
-// Flush ensures all output is written
-func (s *Screen) Flush() {
-	os.Stdout.Sync()
+// WriteRaw queues an escape sequence that doesn't draw cells, such as a
+// clipboard update, to be sent with the next Flush
+func (s *Screen) WriteRaw(seq string) {
+	s.output.WriteString(seq)
+}
+
+// EnterInline makes the screen draw into its height in rows starting at the
+// cursor's line instead of over the whole terminal, scrolling the terminal
+// up if there aren't enough rows below the cursor
+// Positions are relative to the saved cursor position from then on, so
+// nothing else may save the cursor until ExitInline
+func (s *Screen) EnterInline() {
+	s.inline = true
+	s.output.WriteString("\r" + strings.Repeat("\n", max(0, s.height-1)))
+	if s.height > 1 {
+		s.output.WriteString(terminal.CursorUp(s.height - 1))
+	}
+	s.output.WriteString(terminal.CursorSave)
+}
+
+// ExitInline moves the cursor to the start of the line below the drawn
+// rows, leaving them in place, and flushes
+func (s *Screen) ExitInline() error {
+	s.output.WriteString(s.cursorTo(0, max(0, s.height-1)) + "\r\n")
+	s.inline = false
+	return s.Flush()
+}
+
+// cursorTo returns the sequence moving the cursor to (x, y), 0-indexed
+func (s *Screen) cursorTo(x, y int) string {
+	if !s.inline {
+		return terminal.CursorMove(x+1, y+1)
+	}
+	seq := terminal.CursorRestore
+	if y > 0 {
+		seq += terminal.CursorDown(y)
+	}
+	if x > 0 {
+		seq += terminal.CursorForward(x)
+	}
+	return seq
+}
+
+// Flush writes all buffered output to the terminal
+func (s *Screen) Flush() error {
+	return s.output.Flush()
 }
 
 // SetCell sets a cell in the back buffer at a specific z-layer
@@ -225,12 +399,14 @@ func (s *Screen) Fill(cell Cell) {
 }
 
 // ShowCursor moves the cursor to the specified position and shows it
+// The sequence is buffered and sent with the next Flush
 func (s *Screen) ShowCursor(x, y int) {
-	fmt.Print(terminal.CursorMove(x+1, y+1))
-	fmt.Print(terminal.CursorShow)
+	s.output.WriteString(s.cursorTo(x, y))
+	s.output.WriteString(terminal.CursorShow)
 }
 
 // HideCursor hides the cursor
+// The sequence is buffered and sent with the next Flush
 func (s *Screen) HideCursor() {
-	fmt.Print(terminal.CursorHide)
+	s.output.WriteString(terminal.CursorHide)
 }
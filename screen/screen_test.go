@@ -0,0 +1,227 @@
+package screen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/terminal"
+)
+
+// writeCounter records each write made to it
+type writeCounter struct {
+	writes [][]byte
+}
+
+func (w *writeCounter) Write(p []byte) (int, error) {
+	w.writes = append(w.writes, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func TestScreenFlushWritesFrameOnce(t *testing.T) {
+	w := &writeCounter{}
+	s := NewScreenSize(10, 3, w)
+	s.DrawString(0, 0, 0, "hello", terminal.DefaultStyle())
+	s.HideCursor()
+	s.ForceRender()
+	s.ShowCursor(2, 1)
+
+	if len(w.writes) != 0 {
+		t.Fatalf("got %d writes before Flush, want 0", len(w.writes))
+	}
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if len(w.writes) != 1 {
+		t.Fatalf("got %d writes, want the whole frame in 1", len(w.writes))
+	}
+	if got := s.BytesWritten(); got != int64(len(w.writes[0])) {
+		t.Errorf("BytesWritten = %d, want %d", got, len(w.writes[0]))
+	}
+}
+
+func TestScreenRendersToInjectedWriter(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(4, 2, &out)
+	if s.Writer() != &out {
+		t.Fatal("Writer does not return the injected writer")
+	}
+	s.DrawString(1, 1, 0, "hi", terminal.DefaultStyle())
+	s.Render()
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := terminal.CursorMove(2, 2) + terminal.DefaultStyle().Sequence() + "hi" + terminal.StyleReset
+	if out.String() != want {
+		t.Errorf("output %q, want %q", out.String(), want)
+	}
+	if s.ChangedCells() != 2 {
+		t.Errorf("ChangedCells = %d, want 2", s.ChangedCells())
+	}
+}
+
+func TestScreenResizeKeepsDiffBaseline(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(4, 2, &out)
+	s.DrawString(0, 0, 0, "ab", terminal.DefaultStyle())
+	s.Render()
+
+	s.Resize(6, 3)
+	if got := s.Buffer().ToString(); !strings.HasPrefix(got, "ab") {
+		t.Errorf("back buffer after Resize = %q, want the content kept", got)
+	}
+	s.Clear()
+	s.DrawString(0, 0, 0, "ab", terminal.DefaultStyle())
+	s.Flush()
+	out.Reset()
+	s.Render()
+	s.Flush()
+	if out.Len() != 0 {
+		t.Errorf("render after resize wrote %q, want nothing for an unchanged frame", out.String())
+	}
+
+	s.Resize(1, 1)
+	s.Clear()
+	s.DrawString(0, 0, 0, "b", terminal.DefaultStyle())
+	s.Render()
+	s.Flush()
+	want := terminal.CursorMove(1, 1) + terminal.DefaultStyle().Sequence() + "b" + terminal.StyleReset
+	if out.String() != want {
+		t.Errorf("render after shrinking wrote %q, want %q", out.String(), want)
+	}
+}
+
+func TestScreenInline(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(4, 3, &out)
+	s.EnterInline()
+	s.DrawString(1, 2, 0, "hi", terminal.DefaultStyle())
+	s.ForceRender()
+	if err := s.ExitInline(); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+
+	// Rows are reserved below the cursor, which is saved at the top of them
+	reserve := "\r\n\n" + terminal.CursorUp(2) + terminal.CursorSave
+	if !strings.HasPrefix(got, reserve) {
+		t.Errorf("output %q does not start with %q", got, reserve)
+	}
+	for _, seq := range []string{terminal.AltScreenEnter, terminal.AltScreenExit, terminal.CursorHome, terminal.ClearScreen} {
+		if strings.Contains(got, seq) {
+			t.Errorf("inline output %q contains %q", got, seq)
+		}
+	}
+	// The cursor ends on the line below the last frame
+	if want := terminal.CursorRestore + terminal.CursorDown(2) + "\r\n"; !strings.HasSuffix(got, want) {
+		t.Errorf("output %q does not end with %q", got, want)
+	}
+}
+
+func TestScreenInlineCursorRelative(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(4, 3, &out)
+	s.EnterInline()
+	s.Flush()
+	out.Reset()
+
+	s.DrawString(1, 2, 0, "hi", terminal.DefaultStyle())
+	s.Render()
+	s.Flush()
+	want := terminal.CursorRestore + terminal.CursorDown(2) + terminal.CursorForward(1)
+	if !strings.HasPrefix(out.String(), want) {
+		t.Errorf("output %q does not start with %q", out.String(), want)
+	}
+}
+
+func TestScreenDim(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(4, 1, &out)
+	bold := terminal.DefaultStyle().WithBold()
+	s.DrawString(0, 0, 0, "hi", bold)
+	s.SetDim(true)
+	s.Render()
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if want := bold.WithDim().Sequence() + "hi"; !strings.Contains(out.String(), want) {
+		t.Errorf("dimmed output %q does not contain %q", out.String(), want)
+	}
+	if s.back.Get(0, 0, 0).Style.Dim {
+		t.Error("SetDim changed the back buffer")
+	}
+
+	out.Reset()
+	s.SetDim(false)
+	s.Render()
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if want := bold.Sequence() + "hi"; !strings.Contains(out.String(), want) {
+		t.Errorf("output after SetDim(false) %q does not contain %q", out.String(), want)
+	}
+}
+
+func TestScreenBlinkHidden(t *testing.T) {
+	s := NewScreenSize(2, 1, &bytes.Buffer{})
+	s.DrawString(0, 0, 0, "a", terminal.DefaultStyle().WithAppBlink())
+	s.DrawString(1, 0, 0, "b", terminal.DefaultStyle())
+
+	for _, hidden := range []bool{false, true, false} {
+		s.SetBlinkHidden(hidden)
+		row := s.flatten()[0]
+		want := "ab"
+		if hidden {
+			want = " b"
+		}
+		if got := string(row[0].Rune) + string(row[1].Rune); got != want {
+			t.Errorf("SetBlinkHidden(%v): drawn %q, want %q", hidden, got, want)
+		}
+	}
+	if got := s.back.Get(0, 0, 0).Rune; got != 'a' {
+		t.Errorf("back buffer cell = %q after hiding, want 'a'", got)
+	}
+}
+
+func TestScreenHyperlink(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(5, 2, &out)
+	style := terminal.DefaultStyle()
+	s.DrawString(0, 0, 0, "a", style)
+	s.Buffer().DrawLink(1, 0, 0, "go", "https://go.dev", style)
+	s.DrawString(3, 0, 0, "b", style)
+	s.Render()
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := terminal.CursorMove(1, 1) + style.Sequence() + "a" +
+		terminal.Hyperlink("https://go.dev") + "go" + terminal.Hyperlink("") +
+		"b" + terminal.StyleReset
+	if out.String() != want {
+		t.Errorf("output\n%q\nwant\n%q", out.String(), want)
+	}
+}
+
+func TestScreenHyperlinkEndsBeforeMoving(t *testing.T) {
+	var out bytes.Buffer
+	s := NewScreenSize(4, 2, &out)
+	style := terminal.DefaultStyle()
+	s.Buffer().DrawLink(2, 0, 0, "ab", "https://a.example", style)
+	s.Buffer().DrawLink(0, 1, 0, "c", "https://c.example", style)
+	s.Buffer().DrawLink(1, 1, 0, "d", "https://d.example", style)
+	s.Render()
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := terminal.CursorMove(3, 1) + terminal.Hyperlink("https://a.example") + style.Sequence() + "ab" +
+		terminal.Hyperlink("") + terminal.CursorMove(1, 2) +
+		terminal.Hyperlink("https://c.example") + "c" +
+		terminal.Hyperlink("") + terminal.Hyperlink("https://d.example") + "d" +
+		terminal.Hyperlink("") + terminal.StyleReset
+	if out.String() != want {
+		t.Errorf("output\n%q\nwant\n%q", out.String(), want)
+	}
+}
@@ -0,0 +1,82 @@
+package screen
+
+import "unicode"
+
+// wideRanges lists the code point ranges rendered two cells wide
+// (East Asian wide/fullwidth characters and emoji)
+var wideRanges = [][2]rune{
+	{0x1100, 0x115F},   // Hangul Jamo
+	{0x2E80, 0x303E},   // CJK radicals, punctuation
+	{0x3041, 0x33FF},   // Hiragana, Katakana, CJK symbols
+	{0x3400, 0x4DBF},   // CJK extension A
+	{0x4E00, 0x9FFF},   // CJK unified ideographs
+	{0xA000, 0xA4CF},   // Yi
+	{0xAC00, 0xD7A3},   // Hangul syllables
+	{0xF900, 0xFAFF},   // CJK compatibility ideographs
+	{0xFE30, 0xFE4F},   // CJK compatibility forms
+	{0xFF00, 0xFF60},   // Fullwidth forms
+	{0xFFE0, 0xFFE6},   // Fullwidth signs
+	{0x1F300, 0x1F64F}, // Symbols, pictographs, emoticons
+	{0x1F900, 0x1F9FF}, // Supplemental symbols and pictographs
+	{0x20000, 0x3FFFD}, // CJK extensions B and beyond
+}
+
+// RuneWidth returns the number of cells a rune occupies when displayed:
+// 0 for combining and control characters, 2 for wide characters, otherwise 1
+func RuneWidth(r rune) int {
+	if r == 0 || unicode.IsControl(r) || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+	if r < 0x1100 {
+		return 1
+	}
+	for _, rng := range wideRanges {
+		if r < rng[0] {
+			break
+		}
+		if r <= rng[1] {
+			return 2
+		}
+	}
+	return 1
+}
+
+// DisplayWidth returns the number of cells a string occupies when displayed
+func DisplayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += RuneWidth(r)
+	}
+	return width
+}
+
+// Ellipsis is appended to text truncated with Truncate
+const Ellipsis = '…'
+
+// Truncate shortens s to at most maxWidth display cells, cutting on rune
+// boundaries. When ellipsis is true and s doesn't fit, the last cell is
+// replaced with an ellipsis.
+func Truncate(s string, maxWidth int, ellipsis bool) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if DisplayWidth(s) <= maxWidth {
+		return s
+	}
+	limit := maxWidth
+	if ellipsis {
+		limit--
+	}
+	width := 0
+	for i, r := range s {
+		w := RuneWidth(r)
+		if width+w > limit {
+			if ellipsis {
+				return s[:i] + string(Ellipsis)
+			}
+			return s[:i]
+		}
+		width += w
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+package screen
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		s        string
+		width    int
+		ellipsis bool
+		want     string
+	}{
+		{"Charlie", 4, true, "Cha…"},
+		{"Charlie", 4, false, "Char"},
+		{"Bob", 4, true, "Bob"},
+		{"日本語", 4, true, "日…"},
+		{"日本語", 5, false, "日本"}, // A wide rune isn't split
+		{"café", 3, false, "caf"},
+	}
+	for _, tt := range tests {
+		if got := Truncate(tt.s, tt.width, tt.ellipsis); got != tt.want {
+			t.Errorf("Truncate(%q, %d, %v) = %q, want %q", tt.s, tt.width, tt.ellipsis, got, tt.want)
+		}
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"", 0},
+		{"abc", 3},
+		{"café", 4},
+		{"cafe\u0301", 4}, // Combining accent
+		{"Ünïcödé", 7},
+		{"日本語", 6},
+		{"한글", 4},
+		{"ｆｕｌｌ", 8}, // Fullwidth forms
+		{"a日b", 4},
+	}
+	for _, tt := range tests {
+		if got := DisplayWidth(tt.s); got != tt.want {
+			t.Errorf("DisplayWidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
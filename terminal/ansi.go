@@ -1,12 +1,20 @@
 package terminal
 
-import "fmt"
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
 
 // ANSI escape code constants
 const (
 	// Escape sequence start
 	ESC = "\x1b"
 	CSI = ESC + "["
+	OSC = ESC + "]"
+	BEL = "\x07"
+	ST  = ESC + "\\"
 
 	// Screen control
 	ClearScreen      = CSI + "2J"
@@ -55,8 +63,18 @@ const (
 	MouseDisable      = CSI + "?1000l"
 	MouseExtended     = CSI + "?1006h"
 	MouseExtendedOff  = CSI + "?1006l"
+	MouseDrag         = CSI + "?1002h"
+	MouseDragOff      = CSI + "?1002l"
 	MouseAllMotion    = CSI + "?1003h"
 	MouseAllMotionOff = CSI + "?1003l"
+
+	// Window title stack (XTWINOPS)
+	TitleSave    = CSI + "22;0t"
+	TitleRestore = CSI + "23;0t"
+
+	// Focus reporting
+	FocusReportEnable  = CSI + "?1004h"
+	FocusReportDisable = CSI + "?1004l"
 )
 
 // CursorMove returns the escape sequence to move cursor to (x, y)
@@ -120,3 +138,45 @@ func ResetScrollRegion() string {
 	return CSI + "r"
 }
 
+// MaxClipboardBytes is the most base64 text ClipboardSequence sends, as
+// terminals drop OSC 52 sequences above a limit (about 100 kB in xterm
+// and hterm)
+const MaxClipboardBytes = 100000
+
+// ClipboardSequence returns the OSC 52 sequence that sets the system
+// clipboard to data
+// Data too long to encode in MaxClipboardBytes is cut at a character
+// boundary.
+func ClipboardSequence(data string) string {
+	if limit := base64.StdEncoding.DecodedLen(MaxClipboardBytes); len(data) > limit {
+		for limit > 0 && !utf8.RuneStart(data[limit]) {
+			limit--
+		}
+		data = data[:limit]
+	}
+	return OSC + "52;c;" + base64.StdEncoding.EncodeToString([]byte(data)) + BEL
+}
+
+// Hyperlink returns the OSC 8 sequence making the text after it a link to
+// url, or ending the link for ""
+// Control characters would end the sequence early, so they are dropped.
+func Hyperlink(url string) string {
+	return OSC + "8;;" + stripControls(url) + ST
+}
+
+// TitleSequence returns the OSC 0 sequence setting the window and tab
+// title
+// Control characters would end the sequence early, so they are dropped.
+func TitleSequence(title string) string {
+	return OSC + "0;" + stripControls(title) + BEL
+}
+
+// stripControls drops the C0 control characters and DEL from s
+func stripControls(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+}
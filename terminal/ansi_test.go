@@ -0,0 +1,76 @@
+package terminal
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestClipboardSequence(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{"", "\x1b]52;c;\x07"},
+		{"hello", "\x1b]52;c;aGVsbG8=\x07"},
+		{"héllo 日本", "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte("héllo 日本")) + "\x07"},
+	}
+	for _, tt := range tests {
+		if got := ClipboardSequence(tt.data); got != tt.want {
+			t.Errorf("ClipboardSequence(%q) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestClipboardSequenceLimit(t *testing.T) {
+	data := strings.Repeat("日", MaxClipboardBytes) // 3 bytes each
+	seq := ClipboardSequence(data)
+	payload := strings.TrimSuffix(strings.TrimPrefix(seq, OSC+"52;c;"), BEL)
+	if len(payload) > MaxClipboardBytes {
+		t.Errorf("payload is %d bytes, want at most %d", len(payload), MaxClipboardBytes)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		t.Fatalf("payload does not decode: %v", err)
+	}
+	if !utf8.Valid(decoded) || !strings.HasPrefix(data, string(decoded)) {
+		t.Error("cut payload is not a whole-character prefix of the data")
+	}
+}
+
+func TestHyperlink(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://example.com", "\x1b]8;;https://example.com\x1b\\"},
+		{"", "\x1b]8;;\x1b\\"},
+		{"https://a.test/\x1b]8;;x\x07\x1b\\y", "\x1b]8;;https://a.test/]8;;x\\y\x1b\\"}, // Control characters dropped
+	}
+	for _, tt := range tests {
+		if got := Hyperlink(tt.url); got != tt.want {
+			t.Errorf("Hyperlink(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestTitleSequence(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Editor", "\x1b]0;Editor\x07"},
+		{"", "\x1b]0;\x07"},
+		{"a\x07b\x1b]c\x7f", "\x1b]0;ab]c\x07"}, // Control characters dropped
+		{"日本", "\x1b]0;日本\x07"},
+	}
+	for _, tt := range tests {
+		if got := TitleSequence(tt.title); got != tt.want {
+			t.Errorf("TitleSequence(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+	if TitleSave != "\x1b[22;0t" || TitleRestore != "\x1b[23;0t" {
+		t.Errorf("TitleSave, TitleRestore = %q, %q, want XTWINOPS 22 and 23", TitleSave, TitleRestore)
+	}
+}
@@ -85,6 +85,25 @@ func Hex(hex uint32) RGB {
 	}
 }
 
+// Lerp linearly interpolates between c and other
+// t is clamped to the range 0.0 (c) to 1.0 (other)
+func (c RGB) Lerp(other RGB, t float64) RGB {
+	if t < 0 {
+		t = 0
+	}
+	if t > 1 {
+		t = 1
+	}
+	lerp := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+	}
+	return RGB{
+		R: lerp(c.R, other.R),
+		G: lerp(c.G, other.G),
+		B: lerp(c.B, other.B),
+	}
+}
+
 func (c RGB) FG() string {
 	return fmt.Sprintf("%s38;2;%d;%d;%dm", CSI, c.R, c.G, c.B)
 }
@@ -104,6 +123,7 @@ type Style struct {
 	Blink     bool
 	Reverse   bool
 	Strike    bool
+	AppBlink  bool // Blinked by the app rather than the terminal; see Screen.SetBlinkHidden
 }
 
 // DefaultStyle returns a style with default colors and no attributes
@@ -156,6 +176,13 @@ func (s Style) WithBlink() Style {
 	return s
 }
 
+// WithAppBlink returns a copy of the style blinked by the app, which works
+// in terminals that ignore the blink attribute
+func (s Style) WithAppBlink() Style {
+	s.AppBlink = true
+	return s
+}
+
 // WithReverse returns a copy of the style with reverse enabled
 func (s Style) WithReverse() Style {
 	s.Reverse = true
@@ -213,6 +240,7 @@ func (s Style) Equals(other Style) bool {
 		s.Underline == other.Underline &&
 		s.Blink == other.Blink &&
 		s.Reverse == other.Reverse &&
-		s.Strike == other.Strike
+		s.Strike == other.Strike &&
+		s.AppBlink == other.AppBlink
 }
 
@@ -86,6 +86,27 @@ func (t *Terminal) ExitAltScreen() {
 	t.inAltScreen = false
 }
 
+// EnableMouse turns on mouse reporting for presses, releases, drags and the
+// wheel, using SGR coordinates
+func (t *Terminal) EnableMouse() {
+	fmt.Print(MouseEnable + MouseDrag + MouseExtended)
+}
+
+// DisableMouse turns off mouse reporting
+func (t *Terminal) DisableMouse() {
+	fmt.Print(MouseExtendedOff + MouseDragOff + MouseDisable)
+}
+
+// EnableFocusReporting makes the terminal report gaining and losing focus
+func (t *Terminal) EnableFocusReporting() {
+	fmt.Print(FocusReportEnable)
+}
+
+// DisableFocusReporting turns off focus reports
+func (t *Terminal) DisableFocusReporting() {
+	fmt.Print(FocusReportDisable)
+}
+
 // Size returns the current terminal size (width, height)
 func (t *Terminal) Size() (int, int, error) {
 	ws, err := unix.IoctlGetWinsize(t.fd, unix.TIOCGWINSZ)
@@ -0,0 +1,96 @@
+// Package text provides text matching helpers shared by widgets
+package text
+
+import "unicode"
+
+// Fuzzy match scoring
+const (
+	matchScore       = 1 // Each matched character
+	consecutiveBonus = 2 // A character matched right after the previous one
+	boundaryBonus    = 3 // A character starting a word
+	prefixBonus      = 2 // The first character of the target, on top of boundaryBonus
+)
+
+// FuzzyMatch reports whether the characters of query appear in order in
+// target, ignoring case, and scores the best such match
+// Matches at word starts and runs of consecutive characters score higher,
+// so a prefix beats the same letters scattered through the target.
+// positions holds the rune indexes of the matched characters in target,
+// for highlighting. An empty query matches everything with score 0.
+func FuzzyMatch(query, target string) (score int, positions []int, ok bool) {
+	q := []rune(query)
+	t := []rune(target)
+	if len(q) == 0 {
+		return 0, nil, true
+	}
+	if len(q) > len(t) {
+		return 0, nil, false
+	}
+	for i, r := range q {
+		q[i] = unicode.ToLower(r)
+	}
+
+	// best[i][j] is the best score for matching q[:i+1] with q[i] at t[j],
+	// or -1 if there is no such match; from[i][j] is where q[i-1] matched
+	best := make([][]int, len(q))
+	from := make([][]int, len(q))
+	for i := range q {
+		best[i] = make([]int, len(t))
+		from[i] = make([]int, len(t))
+		prevMax, prevAt := -1, -1 // Best score for q[:i] ending before j-1
+		for j := range t {
+			best[i][j] = -1
+			if i > 0 && j >= 2 && best[i-1][j-2] > prevMax {
+				prevMax, prevAt = best[i-1][j-2], j-2
+			}
+			if unicode.ToLower(t[j]) != q[i] {
+				continue
+			}
+			bonus := matchScore + wordStartBonus(t, j)
+			if i == 0 {
+				best[i][j] = bonus
+				continue
+			}
+			if prevMax >= 0 {
+				best[i][j], from[i][j] = prevMax+bonus, prevAt
+			}
+			if j > 0 && best[i-1][j-1] >= 0 && best[i-1][j-1]+consecutiveBonus+bonus > best[i][j] {
+				best[i][j], from[i][j] = best[i-1][j-1]+consecutiveBonus+bonus, j-1
+			}
+		}
+	}
+
+	last := len(q) - 1
+	end := -1
+	for j := range t {
+		if best[last][j] >= 0 && (end < 0 || best[last][j] > best[last][end]) {
+			end = j
+		}
+	}
+	if end < 0 {
+		return 0, nil, false
+	}
+	score = best[last][end]
+	positions = make([]int, len(q))
+	for i, j := last, end; i >= 0; i-- {
+		positions[i] = j
+		j = from[i][j]
+	}
+	return score, positions, true
+}
+
+// wordStartBonus returns the bonus for a match at t[j] starting a word:
+// the start of the target, after a separator, or a capital in camelCase
+func wordStartBonus(t []rune, j int) int {
+	if j == 0 {
+		return boundaryBonus + prefixBonus
+	}
+	prev := t[j-1]
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+		return boundaryBonus
+	}
+	if unicode.IsLower(prev) && unicode.IsUpper(t[j]) {
+		return boundaryBonus
+	}
+	return 0
+}
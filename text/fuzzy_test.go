@@ -0,0 +1,61 @@
+package text
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		query, target string
+		ok            bool
+		positions     []int
+	}{
+		{"", "anything", true, nil},
+		{"abc", "abc", true, []int{0, 1, 2}},
+		{"ABC", "a-b-c", true, []int{0, 2, 4}},
+		{"ofi", "Open File", true, []int{0, 5, 6}},
+		{"cba", "abc", false, nil},
+		{"abcd", "abc", false, nil},
+		{"x", "", false, nil},
+		{"cf", "café", true, []int{0, 2}},
+		{"né", "naïve été", true, []int{0, 6}},
+		{"本語", "日本語", true, []int{1, 2}},
+	}
+	for _, tt := range tests {
+		_, positions, ok := FuzzyMatch(tt.query, tt.target)
+		if ok != tt.ok || !slices.Equal(positions, tt.positions) {
+			t.Errorf("FuzzyMatch(%q, %q) = %v, %v, want %v, %v", tt.query, tt.target, positions, ok, tt.positions, tt.ok)
+		}
+	}
+}
+
+func TestFuzzyMatchScoreOrder(t *testing.T) {
+	tests := []struct {
+		query, better, worse string
+	}{
+		{"save", "Save File", "Reset Layout View"}, // Prefix beats scattered
+		{"file", "Open File", "Edit profile"},
+		{"ab", "ab", "axb"},                 // Consecutive beats separated
+		{"fb", "fooBar", "foobar"},          // camelCase boundary
+		{"log", "Logs", "Catalog"},          // Start of target
+		{"view", "Toggle View", "Overview"}, // Word start beats mid-word
+	}
+	for _, tt := range tests {
+		better, _, ok1 := FuzzyMatch(tt.query, tt.better)
+		worse, _, ok2 := FuzzyMatch(tt.query, tt.worse)
+		if !ok1 || !ok2 || better <= worse {
+			t.Errorf("FuzzyMatch(%q): %q scored %d, %q scored %d; want the first higher",
+				tt.query, tt.better, better, tt.worse, worse)
+		}
+	}
+}
+
+func TestFuzzyMatchPrefersBestPositions(t *testing.T) {
+	// "ap" matches first within "map", but "app" at a word start scores
+	// higher
+	_, positions, _ := FuzzyMatch("ap", "map app")
+	if !slices.Equal(positions, []int{4, 5}) {
+		t.Errorf("positions = %v, want [4 5]", positions)
+	}
+}
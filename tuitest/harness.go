@@ -0,0 +1,115 @@
+// Package tuitest drives widgets and apps without a terminal, for tests
+//
+// A Harness wraps a widget tree in an app, sends it input events and
+// renders it into an in-memory buffer:
+//
+//	h := tuitest.NewHarness(list, 20, 5)
+//	h.SendKey(input.KeyDown, input.ModNone)
+//	h.AssertContains(t, "> Second")
+package tuitest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/app"
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/widget"
+)
+
+// Harness runs a widget tree in an app drawn to an in-memory buffer
+type Harness struct {
+	app    *app.App
+	width  int
+	height int
+}
+
+// NewHarness creates a harness showing root in a width by height screen,
+// with root focused
+func NewHarness(root widget.Widget, width, height int) *Harness {
+	root.SetFocused(true)
+	return &Harness{
+		app:    app.New().SetRoot(root),
+		width:  width,
+		height: height,
+	}
+}
+
+// App returns the app the widgets run in, e.g. to add key bindings
+func (h *Harness) App() *app.App {
+	return h.app
+}
+
+// Send delivers event as if it had been read from the terminal
+func (h *Harness) Send(event input.Event) *Harness {
+	h.app.Dispatch(event)
+	return h
+}
+
+// SendKey sends a key press
+func (h *Harness) SendKey(key input.Key, mod input.Modifier) *Harness {
+	return h.Send(input.KeyEvent{Key: key, Modifier: mod})
+}
+
+// SendRune sends a typed character
+func (h *Harness) SendRune(r rune) *Harness {
+	return h.Send(input.KeyEvent{Key: input.KeyRune, Rune: r})
+}
+
+// SendText types each character of s
+func (h *Harness) SendText(s string) *Harness {
+	for _, r := range s {
+		h.SendRune(r)
+	}
+	return h
+}
+
+// SendMouse sends a mouse event at cell (x, y)
+// The UI is rendered first, so the event reaches the widgets drawn there.
+func (h *Harness) SendMouse(button input.MouseButton, x, y int) *Harness {
+	h.Render()
+	return h.Send(input.MouseEvent{X: x, Y: y, Button: button})
+}
+
+// Resize changes the screen size and sends the resize event
+func (h *Harness) Resize(width, height int) *Harness {
+	h.width, h.height = width, height
+	return h.Send(input.ResizeEvent{Width: width, Height: height})
+}
+
+// Buffer renders the UI and returns the buffer it was drawn in
+func (h *Harness) Buffer() *screen.Buffer {
+	buf := screen.NewBuffer(h.width, h.height, screen.DefaultDepth)
+	h.app.RenderTo(buf)
+	return buf
+}
+
+// Render renders the UI and returns it as plain text, a line per row
+func (h *Harness) Render() string {
+	return h.Buffer().ToString()
+}
+
+// AssertContains renders the UI and fails t, showing the screen, if it
+// doesn't contain substr
+func (h *Harness) AssertContains(t testing.TB, substr string) bool {
+	t.Helper()
+	out := h.Render()
+	if !strings.Contains(out, substr) {
+		t.Errorf("screen does not contain %q:\n%s", substr, out)
+		return false
+	}
+	return true
+}
+
+// AssertNotContains renders the UI and fails t, showing the screen, if it
+// contains substr
+func (h *Harness) AssertNotContains(t testing.TB, substr string) bool {
+	t.Helper()
+	out := h.Render()
+	if strings.Contains(out, substr) {
+		t.Errorf("screen unexpectedly contains %q:\n%s", substr, out)
+		return false
+	}
+	return true
+}
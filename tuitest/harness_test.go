@@ -0,0 +1,92 @@
+package tuitest
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/widget"
+)
+
+// numberedList returns a list of n items "Item 0", "Item 1", ...
+func numberedList(n int) *widget.List {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = "Item " + strconv.Itoa(i)
+	}
+	return widget.NewList().SetStrings(items)
+}
+
+// recorder is a testing.TB that records failures instead of failing
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestHarnessDrivesList(t *testing.T) {
+	list := numberedList(10)
+	h := NewHarness(list, 12, 3)
+	h.AssertContains(t, "Item 0")
+	h.AssertNotContains(t, "Item 3")
+
+	for range 3 {
+		h.SendKey(input.KeyDown, input.ModNone)
+	}
+	h.AssertContains(t, "Item 3")
+	h.AssertNotContains(t, "Item 0")
+	if list.Cursor() != 3 {
+		t.Errorf("Cursor() = %d, want 3", list.Cursor())
+	}
+
+	h.SendKey(input.KeyEnter, input.ModNone)
+	buf := h.Buffer()
+	if got := buf.Get(0, 2, 0).Style.BG; got != terminal.ColorGreen {
+		t.Errorf("selected row background = %v, want green", got)
+	}
+	if got := list.Selected(); len(got) != 1 || got[0] != 3 {
+		t.Errorf("Selected() = %v, want [3]", got)
+	}
+}
+
+func TestHarnessSendRuneAndMouse(t *testing.T) {
+	list := widget.NewList().SetStrings([]string{"Apple", "Banana", "Cherry"}).SetTypeAhead(true)
+	h := NewHarness(list, 12, 3)
+
+	h.SendRune('c')
+	if list.Cursor() != 2 {
+		t.Errorf("after typing c Cursor() = %d, want 2", list.Cursor())
+	}
+	h.SendMouse(input.MouseLeft, 1, 1)
+	if list.Cursor() != 1 {
+		t.Errorf("after clicking row 1 Cursor() = %d, want 1", list.Cursor())
+	}
+}
+
+func TestHarnessResize(t *testing.T) {
+	h := NewHarness(numberedList(10), 12, 2)
+	h.AssertNotContains(t, "Item 4")
+	h.Resize(12, 6)
+	h.AssertContains(t, "Item 4")
+}
+
+func TestHarnessAssertionsReport(t *testing.T) {
+	h := NewHarness(numberedList(2), 12, 2)
+	r := &recorder{TB: t}
+	if !h.AssertContains(r, "Item 1") || len(r.errors) != 0 {
+		t.Errorf("AssertContains failed for text on screen: %v", r.errors)
+	}
+	if h.AssertContains(r, "Item 9") || len(r.errors) != 1 {
+		t.Errorf("AssertContains passed for text not on screen")
+	}
+	if h.AssertNotContains(r, "Item 0") || len(r.errors) != 2 {
+		t.Errorf("AssertNotContains passed for text on screen")
+	}
+}
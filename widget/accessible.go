@@ -0,0 +1,84 @@
+package widget
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Accessible is implemented by widgets that can describe themselves in
+// words, for screen readers and tests
+type Accessible interface {
+	// AccessibleDescription returns the widget kind followed by its
+	// value and state, e.g. "TextInput value='bob' [focused]"
+	AccessibleDescription() string
+}
+
+// labeler is implemented by containers that label their children, like a
+// Form does its fields
+type labeler interface {
+	childLabel(child Widget) string
+}
+
+// AccessibilityTree describes the visible widgets within root, one per
+// line, each prefixed by the widgets containing it
+// e.g. "Form 'Sign up' > TextInput 'Username' value='bob' [focused]"
+// Widgets that are not Accessible are described by their type name.
+func AccessibilityTree(root Widget) string {
+	var b strings.Builder
+	describeTree(&b, root, "", "")
+	return b.String()
+}
+
+// describeTree writes the lines for w, labelled label, below path
+func describeTree(b *strings.Builder, w Widget, label, path string) {
+	if w == nil || !isVisible(w) {
+		return
+	}
+	desc := Describe(w)
+	if label != "" {
+		kind, rest, _ := strings.Cut(desc, " ")
+		desc = strings.TrimSpace(kind + " " + quote(label) + " " + rest)
+	}
+	if path != "" {
+		desc = path + " > " + desc
+	}
+	b.WriteString(desc)
+	b.WriteByte('\n')
+
+	parent, ok := w.(interface{ Children() []Widget })
+	if !ok {
+		return
+	}
+	labels, _ := w.(labeler)
+	for _, child := range parent.Children() {
+		childLabel := ""
+		if labels != nil {
+			childLabel = labels.childLabel(child)
+		}
+		describeTree(b, child, childLabel, desc)
+	}
+}
+
+// Describe returns the accessible description of w, or its type name and
+// focus if it is not Accessible
+func Describe(w Widget) string {
+	if a, ok := w.(Accessible); ok {
+		return a.AccessibleDescription()
+	}
+	name := fmt.Sprintf("%T", w)
+	name = name[strings.LastIndexAny(name, ".*")+1:]
+	return name + focusState(w)
+}
+
+// quote wraps s in single quotes for a description
+func quote(s string) string {
+	return "'" + s + "'"
+}
+
+// focusState returns " [focused]" if w has focus
+func focusState(w Widget) string {
+	if w.IsFocused() {
+		return " [focused]"
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestAccessibilityTreeForm(t *testing.T) {
+	f := NewForm().SetTitle("Sign up")
+	name := f.AddTextInput("Username", "")
+	f.AddPasswordInput("Password", "").SetValue("secret")
+	f.AddSubmitButton("OK")
+	f.SetFocused(true)
+
+	want := "Form 'Sign up'\n" +
+		"Form 'Sign up' > TextInput 'Username' value='' [focused]\n" +
+		"Form 'Sign up' > TextInput 'Password' value='******'\n" +
+		"Form 'Sign up' > Button 'OK'\n"
+	if got := AccessibilityTree(f); got != want {
+		t.Errorf("AccessibilityTree() =\n%s\nwant\n%s", got, want)
+	}
+
+	name.SetValue("bob")
+	f.HandleEvent(input.KeyEvent{Key: input.KeyTab})
+	want = "Form 'Sign up'\n" +
+		"Form 'Sign up' > TextInput 'Username' value='bob'\n" +
+		"Form 'Sign up' > TextInput 'Password' value='******' [focused]\n" +
+		"Form 'Sign up' > Button 'OK'\n"
+	if got := AccessibilityTree(f); got != want {
+		t.Errorf("after Tab AccessibilityTree() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestAccessibilityTreeSkipsHidden(t *testing.T) {
+	f := NewForm()
+	f.AddTextInput("Shown", "")
+	f.AddTextInput("Hidden", "").SetVisible(false)
+
+	want := "Form\nForm > TextInput 'Shown' value='' [focused]\n"
+	if got := AccessibilityTree(f); got != want {
+		t.Errorf("AccessibilityTree() = %q, want %q", got, want)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	list := NewList().SetItems([]ListItem{{Text: "a"}, {Text: "b"}})
+	list.SetFocused(true)
+	on := NewButton("Bold").SetToggle(true).SetOn(true)
+	tests := []struct {
+		w    Widget
+		want string
+	}{
+		{list, "List items=2 current='a' [focused]"},
+		{on, "Button 'Bold' [on]"},
+		{NewSecureTextInput(), "SecureTextInput length=0"},
+		{&toggle{BaseWidget: NewBaseWidget()}, "toggle"},
+	}
+	for _, tt := range tests {
+		if got := Describe(tt.w); got != tt.want {
+			t.Errorf("Describe(%T) = %q, want %q", tt.w, got, tt.want)
+		}
+	}
+}
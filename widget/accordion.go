@@ -0,0 +1,299 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// AccordionSection is a titled, collapsible section of an Accordion
+type AccordionSection struct {
+	Title    string
+	Content  Widget
+	Expanded bool
+}
+
+// Accordion is a stack of collapsible sections where only expanded sections
+// render their content
+// Up/Down move between headers and Enter or Space toggles a section; Tab
+// moves focus into the content of an expanded section and Escape or
+// Shift+Tab moves it back to the headers
+type Accordion struct {
+	BaseWidget
+	sections       []*AccordionSection
+	cursor         int
+	multiOpen      bool
+	contentFocused bool
+	indent         int
+	style          terminal.Style
+	headerStyle    terminal.Style
+	cursorStyle    terminal.Style
+}
+
+// NewAccordion creates a new accordion widget
+// Only one section is expanded at a time unless AllowMultiOpen is set
+func NewAccordion() *Accordion {
+	a := &Accordion{
+		BaseWidget:  NewBaseWidget(),
+		indent:      2,
+		style:       terminal.DefaultStyle(),
+		headerStyle: terminal.DefaultStyle().WithBold(),
+		cursorStyle: terminal.DefaultStyle().WithReverse(),
+	}
+	a.SetInteractive(true)
+	return a
+}
+
+// AddSection adds a collapsed section
+func (a *Accordion) AddSection(title string, content Widget) *Accordion {
+	a.sections = append(a.sections, &AccordionSection{Title: title, Content: content})
+	return a
+}
+
+// Sections returns the sections
+func (a *Accordion) Sections() []*AccordionSection {
+	return a.sections
+}
+
+// AllowMultiOpen sets whether several sections may be expanded at once
+// When disabled, expanding a section collapses the others
+func (a *Accordion) AllowMultiOpen(allow bool) *Accordion {
+	a.multiOpen = allow
+	return a
+}
+
+// SetIndent sets how far section content is indented from its header
+func (a *Accordion) SetIndent(indent int) *Accordion {
+	a.indent = max(0, indent)
+	return a
+}
+
+// SetStyle sets the content area style
+func (a *Accordion) SetStyle(style terminal.Style) *Accordion {
+	a.style = style
+	return a
+}
+
+// SetHeaderStyle sets the section header style
+func (a *Accordion) SetHeaderStyle(style terminal.Style) *Accordion {
+	a.headerStyle = style
+	return a
+}
+
+// SetCursorStyle sets the style of the header under the cursor
+func (a *Accordion) SetCursorStyle(style terminal.Style) *Accordion {
+	a.cursorStyle = style
+	return a
+}
+
+// Expand expands section i, collapsing the others unless multi-open is allowed
+func (a *Accordion) Expand(i int) *Accordion {
+	if i < 0 || i >= len(a.sections) {
+		return a
+	}
+	if !a.multiOpen {
+		for j, section := range a.sections {
+			if j != i {
+				section.Expanded = false
+			}
+		}
+	}
+	a.sections[i].Expanded = true
+	return a
+}
+
+// Collapse collapses section i
+func (a *Accordion) Collapse(i int) *Accordion {
+	if i < 0 || i >= len(a.sections) {
+		return a
+	}
+	a.sections[i].Expanded = false
+	if i == a.cursor {
+		a.blurContent()
+	}
+	return a
+}
+
+// Toggle expands or collapses section i
+func (a *Accordion) Toggle(i int) *Accordion {
+	if i < 0 || i >= len(a.sections) {
+		return a
+	}
+	if a.sections[i].Expanded {
+		return a.Collapse(i)
+	}
+	return a.Expand(i)
+}
+
+// IsExpanded returns whether section i is expanded
+func (a *Accordion) IsExpanded(i int) bool {
+	return i >= 0 && i < len(a.sections) && a.sections[i].Expanded
+}
+
+// Cursor returns the index of the section under the cursor
+func (a *Accordion) Cursor() int {
+	return a.cursor
+}
+
+// Render draws the headers and the content of expanded sections
+func (a *Accordion) Render(buf *screen.Buffer, bounds layout.Rect) {
+	a.bounds = bounds
+	if !a.visible || bounds.IsEmpty() {
+		return
+	}
+
+	y := bounds.Y
+	bottom := bounds.Y + bounds.Height
+	for i, section := range a.sections {
+		if y >= bottom {
+			break
+		}
+
+		style := a.headerStyle
+		if i == a.cursor && a.focused && !a.contentFocused {
+			style = a.cursorStyle
+		}
+		marker := '▸'
+		if section.Expanded {
+			marker = '▾'
+		}
+		buf.FillRect(bounds.X, y, bounds.Z, bounds.Width, 1, screen.NewCell(' ', style))
+		buf.Set(bounds.X, y, bounds.Z, screen.NewCell(marker, style))
+		title := screen.Truncate(section.Title, bounds.Width-2, true)
+		buf.DrawString(bounds.X+2, y, bounds.Z, title, style)
+		y++
+
+		if !section.Expanded || section.Content == nil {
+			continue
+		}
+		height := min(section.Content.Size().Height, bottom-y)
+		if height <= 0 {
+			continue
+		}
+		indent := min(a.indent, bounds.Width)
+		contentBounds := layout.NewRect(bounds.X+indent, y, bounds.Z, bounds.Width-indent, height)
+		section.Content.Render(buf.Clip(contentBounds), contentBounds)
+		y += height
+	}
+}
+
+// HandleEvent handles input events
+func (a *Accordion) HandleEvent(event input.Event) bool {
+	return a.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx handles input events, passing ctx to the open section's
+// content
+func (a *Accordion) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !a.visible || !a.focused || len(a.sections) == 0 {
+		return false
+	}
+
+	keyEvent, ok := event.(input.KeyEvent)
+	if a.contentFocused {
+		if ok && (keyEvent.Key == input.KeyEscape || (keyEvent.Key == input.KeyTab && keyEvent.IsShift())) {
+			a.blurContent()
+			return true
+		}
+		return HandleEventCtx(a.sections[a.cursor].Content, ctx, event)
+	}
+	if !ok {
+		return false
+	}
+
+	switch keyEvent.Key {
+	case input.KeyUp:
+		if a.cursor > 0 {
+			a.cursor--
+		}
+		return true
+	case input.KeyDown:
+		if a.cursor < len(a.sections)-1 {
+			a.cursor++
+		}
+		return true
+	case input.KeyHome:
+		a.cursor = 0
+		return true
+	case input.KeyEnd:
+		a.cursor = len(a.sections) - 1
+		return true
+	case input.KeyEnter:
+		a.Toggle(a.cursor)
+		return true
+	case input.KeyRune:
+		if keyEvent.Rune == ' ' {
+			a.Toggle(a.cursor)
+			return true
+		}
+	case input.KeyTab:
+		if !keyEvent.IsShift() {
+			return a.focusContent()
+		}
+	}
+
+	return false
+}
+
+// focusContent moves focus into the content of the section under the
+// cursor if it is expanded and interactive
+func (a *Accordion) focusContent() bool {
+	section := a.sections[a.cursor]
+	if !section.Expanded || section.Content == nil || !section.Content.IsInteractive() {
+		return false
+	}
+	a.contentFocused = true
+	section.Content.SetFocused(true)
+	return true
+}
+
+// blurContent moves focus from section content back to the headers
+func (a *Accordion) blurContent() {
+	if !a.contentFocused {
+		return
+	}
+	a.contentFocused = false
+	if a.cursor < len(a.sections) && a.sections[a.cursor].Content != nil {
+		a.sections[a.cursor].Content.SetFocused(false)
+	}
+}
+
+// SetFocused sets focus state
+// Losing focus also moves focus out of any section content
+func (a *Accordion) SetFocused(focused bool) {
+	if !focused {
+		a.blurContent()
+	}
+	a.BaseWidget.SetFocused(focused)
+}
+
+// Children returns the content widgets of all sections
+func (a *Accordion) Children() []Widget {
+	children := make([]Widget, 0, len(a.sections))
+	for _, section := range a.sections {
+		if section.Content != nil {
+			children = append(children, section.Content)
+		}
+	}
+	return children
+}
+
+// Size returns the preferred size
+func (a *Accordion) Size() layout.Size {
+	width, height := 0, len(a.sections)
+	for _, section := range a.sections {
+		width = max(width, 2+screen.DisplayWidth(section.Title))
+		if section.Expanded && section.Content != nil {
+			size := section.Content.Size()
+			width = max(width, a.indent+size.Width)
+			height += size.Height
+		}
+	}
+	return layout.NewSize(width, height)
+}
+
+// MinSize returns the minimum size
+func (a *Accordion) MinSize() layout.Size {
+	return layout.NewSize(3, len(a.sections))
+}
@@ -0,0 +1,56 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// renderedLines returns the number of non-blank lines w draws in a
+// width by height buffer
+func renderedLines(w Widget, width, height int) int {
+	lines := 0
+	for _, line := range strings.Split(renderWidget(w, width, height).ToString(), "\n") {
+		if line != "" {
+			lines++
+		}
+	}
+	return lines
+}
+
+func TestAccordionToggleChangesHeight(t *testing.T) {
+	a := NewAccordion().
+		AddSection("One", NewText("1a\n1b")).
+		AddSection("Two", NewText("2a\n2b\n2c"))
+	a.SetFocused(true)
+
+	if h := a.Size().Height; h != 2 || renderedLines(a, 10, 10) != 2 {
+		t.Fatalf("collapsed height %d, want only the 2 headers", h)
+	}
+	a.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if h := a.Size().Height; h != 4 || renderedLines(a, 10, 10) != 4 {
+		t.Errorf("height %d with the first section expanded, want 4", h)
+	}
+	a.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if h := a.Size().Height; h != 2 || renderedLines(a, 10, 10) != 2 {
+		t.Errorf("height %d after collapsing again, want 2", h)
+	}
+}
+
+func TestAccordionExclusiveExpand(t *testing.T) {
+	a := NewAccordion().
+		AddSection("One", NewText("1")).
+		AddSection("Two", NewText("2")).
+		AddSection("Three", NewText("3"))
+
+	a.Expand(0).Expand(2)
+	if a.IsExpanded(0) || !a.IsExpanded(2) {
+		t.Errorf("expanded 0: %v, 2: %v; want expanding 2 to collapse 0", a.IsExpanded(0), a.IsExpanded(2))
+	}
+
+	a.AllowMultiOpen(true).Expand(0)
+	if !a.IsExpanded(0) || !a.IsExpanded(2) {
+		t.Errorf("expanded 0: %v, 2: %v; want both open with multi-open", a.IsExpanded(0), a.IsExpanded(2))
+	}
+}
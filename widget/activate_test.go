@@ -0,0 +1,99 @@
+package widget
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// callbacks records which of the highlight, select and activate callbacks
+// fired, in order
+type callbacks []string
+
+func (c *callbacks) record(name string) func() {
+	return func() { *c = append(*c, name) }
+}
+
+func TestListCallbacks(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []input.Event
+		want   callbacks
+	}{
+		{"down", []input.Event{input.KeyEvent{Key: input.KeyDown}}, callbacks{"highlight"}},
+		{"space", []input.Event{input.KeyEvent{Key: input.KeyRune, Rune: ' '}}, callbacks{"select"}},
+		{"enter", []input.Event{input.KeyEvent{Key: input.KeyEnter}}, callbacks{"activate"}},
+		{"click", []input.Event{leftClick(0, 0, false)}, callbacks{"select"}},
+		{"double-click", []input.Event{leftClick(0, 0, false), leftClick(0, 0, true)}, callbacks{"select", "activate"}},
+	}
+	for _, tt := range tests {
+		var got callbacks
+		highlight, sel, activate := got.record("highlight"), got.record("select"), got.record("activate")
+		l := NewList().SetStrings(numberedStrings(3)).SetCardinality(0).
+			OnHighlight(func(int, ListItem) { highlight() }).
+			OnSelect(func(int, ListItem) { sel() }).
+			OnActivate(func(int, ListItem) { activate() })
+		l.SetFocused(true)
+		renderWidget(l, 5, 3)
+		for _, event := range tt.events {
+			l.HandleEvent(event)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s fired %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTableCallbacks(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []input.Event
+		want   callbacks
+	}{
+		{"down", []input.Event{input.KeyEvent{Key: input.KeyDown}}, callbacks{"highlight"}},
+		{"enter", []input.Event{input.KeyEvent{Key: input.KeyEnter}}, callbacks{"activate"}},
+		{"click", []input.Event{leftClick(0, 0, false)}, nil},
+		{"double-click", []input.Event{leftClick(0, 0, false), leftClick(0, 0, true)}, callbacks{"activate"}},
+	}
+	for _, tt := range tests {
+		var got callbacks
+		highlight, activate := got.record("highlight"), got.record("activate")
+		table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(3)).
+			OnHighlight(func(int) { highlight() }).
+			OnActivate(func(int) { activate() })
+		table.SetFocused(true)
+		renderWidget(table, 5, 3)
+		for _, event := range tt.events {
+			table.HandleEvent(event)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s fired %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMenuCallbacks(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []input.Event
+		want   callbacks
+	}{
+		{"down", []input.Event{input.KeyEvent{Key: input.KeyDown}}, callbacks{"highlight"}},
+		{"enter", []input.Event{input.KeyEvent{Key: input.KeyEnter}}, callbacks{"activate"}},
+	}
+	for _, tt := range tests {
+		var got callbacks
+		highlight, activate := got.record("highlight"), got.record("activate")
+		m := numberedMenu(3).
+			OnHighlight(func(int, *MenuItem) { highlight() }).
+			OnActivate(func(int, *MenuItem) { activate() })
+		renderWidget(m, 10, 3)
+		for _, event := range tt.events {
+			m.HandleEvent(event)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s fired %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
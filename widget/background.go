@@ -0,0 +1,52 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// background is an optional fill drawn under a widget's content, so cells
+// the content doesn't cover don't keep whatever was drawn there before
+type background struct {
+	style   terminal.Style
+	enabled bool
+}
+
+// set enables the fill in style
+func (b *background) set(style terminal.Style) {
+	b.style = style
+	b.enabled = true
+}
+
+// fill clears bounds with spaces in the background style, if enabled
+func (b background) fill(buf *screen.Buffer, bounds layout.Rect) {
+	if b.enabled {
+		buf.FillRect(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, screen.NewCell(' ', b.style))
+	}
+}
+
+// zebra alternates the style of rows by their index in the content, so
+// the stripes stay put while scrolling
+type zebra struct {
+	even    terminal.Style
+	odd     terminal.Style
+	enabled bool
+}
+
+// set enables striping with the given styles
+func (z *zebra) set(even, odd terminal.Style) {
+	z.even, z.odd = even, odd
+	z.enabled = true
+}
+
+// style returns the style of row index, or base if striping is off
+func (z zebra) style(index int, base terminal.Style) terminal.Style {
+	if !z.enabled {
+		return base
+	}
+	if index%2 == 0 {
+		return z.even
+	}
+	return z.odd
+}
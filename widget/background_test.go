@@ -0,0 +1,65 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// backgroundWidgets returns each widget with SetBackground, set to style
+// if fill is true, with content covering only their top row
+func backgroundWidgets(fill bool, style terminal.Style) map[string]Widget {
+	list := NewList().SetStrings([]string{"a"})
+	table := newTestTable([]TableColumn{{Title: "N", Width: 2}}, numberedRows(1))
+	menu := numberedMenu(1)
+	form := NewForm().SetShowBorder(false)
+	form.AddTextInput("N", "")
+	if fill {
+		list.SetBackground(style)
+		table.SetBackground(style)
+		menu.SetBackground(style)
+		form.SetBackground(style)
+	}
+	return map[string]Widget{"List": list, "Table": table, "Menu": menu, "Form": form}
+}
+
+func TestBackgroundFillsBounds(t *testing.T) {
+	bg := terminal.DefaultStyle().WithBG(terminal.ColorBlue)
+	for name, w := range backgroundWidgets(true, bg) {
+		buf := screen.NewBuffer(12, 6, screen.DefaultDepth)
+		buf.FillRect(0, 0, 0, 12, 6, screen.NewCell('x', terminal.DefaultStyle()))
+		bounds := layout.NewRect(1, 1, 0, 10, 4)
+		w.Render(buf, bounds)
+
+		for y := 0; y < 6; y++ {
+			for x := 0; x < 12; x++ {
+				cell := buf.Get(x, y, 0)
+				if !bounds.Contains(x, y) {
+					if cell.Rune != 'x' {
+						t.Errorf("%s: cell (%d, %d) outside the bounds = %q, want untouched", name, x, y, cell.Rune)
+					}
+					continue
+				}
+				if cell.Rune == 'x' {
+					t.Errorf("%s: cell (%d, %d) not filled", name, x, y)
+				}
+				if y > 1 && cell.Style != bg {
+					t.Errorf("%s: cell (%d, %d) style = %v, want the background", name, x, y, cell.Style)
+				}
+			}
+		}
+	}
+}
+
+func TestBackgroundOffByDefault(t *testing.T) {
+	for name, w := range backgroundWidgets(false, terminal.Style{}) {
+		buf := screen.NewBuffer(10, 4, screen.DefaultDepth)
+		buf.FillRect(0, 0, 0, 10, 4, screen.NewCell('x', terminal.DefaultStyle()))
+		w.Render(buf, layout.NewRect(0, 0, 0, 10, 4))
+		if got := buf.Get(9, 3, 0).Rune; got != 'x' {
+			t.Errorf("%s: cell below the content = %q, want it left alone", name, got)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package widget
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// bigGlyphHeight and bigGlyphWidth are the size of the glyph patterns
+const (
+	bigGlyphHeight = 5
+	bigGlyphWidth  = 3
+)
+
+// bigGlyphs are 3x5 patterns, rows separated by spaces, '#' for filled
+// Lowercase letters use the uppercase glyphs.
+var bigGlyphs = map[rune]string{
+	'0': "### #.# #.# #.# ###", '1': ".#. ##. .#. .#. ###",
+	'2': "### ..# ### #.. ###", '3': "### ..# ### ..# ###",
+	'4': "#.# #.# ### ..# ..#", '5': "### #.. ### ..# ###",
+	'6': "### #.. ### #.# ###", '7': "### ..# ..# ..# ..#",
+	'8': "### #.# ### #.# ###", '9': "### #.# ### ..# ###",
+	'A': ".#. #.# ### #.# #.#", 'B': "##. #.# ##. #.# ##.",
+	'C': ".## #.. #.. #.. .##", 'D': "##. #.# #.# #.# ##.",
+	'E': "### #.. ##. #.. ###", 'F': "### #.. ##. #.. #..",
+	'G': ".## #.. #.# #.# .##", 'H': "#.# #.# ### #.# #.#",
+	'I': "### .#. .#. .#. ###", 'J': "..# ..# ..# #.# .#.",
+	'K': "#.# #.# ##. #.# #.#", 'L': "#.. #.. #.. #.. ###",
+	'M': "#.# ### ### #.# #.#", 'N': "##. #.# #.# #.# #.#",
+	'O': ".#. #.# #.# #.# .#.", 'P': "##. #.# ##. #.. #..",
+	'Q': ".#. #.# #.# ##. .##", 'R': "##. #.# ##. #.# #.#",
+	'S': ".## #.. .#. ..# ##.", 'T': "### .#. .#. .#. .#.",
+	'U': "#.# #.# #.# #.# ###", 'V': "#.# #.# #.# #.# .#.",
+	'W': "#.# #.# ### ### #.#", 'X': "#.# #.# .#. #.# #.#",
+	'Y': "#.# #.# .#. .#. .#.", 'Z': "### ..# .#. #.. ###",
+	' ': "... ... ... ... ...", '.': "... ... ... ... .#.",
+	',': "... ... ... .#. #..", '=': "... ### ... ### ...",
+	':': "... .#. ... .#. ...", '-': "... ... ### ... ...",
+	'+': "... .#. ### .#. ...",
+	'!': ".#. .#. .#. ... .#.", '?': "##. ..# .#. ... .#.",
+	'/': "..# ..# .#. #.. #..", '%': "#.# ..# .#. #.. #.#",
+}
+
+// BigFont is a banner font drawing each character as a block of cells
+// Glyphs are 5 rows tall; characters without a glyph are drawn blank.
+type BigFont struct {
+	fill  rune // Drawn for filled pixels
+	scale int  // Columns per pixel
+}
+
+var (
+	// FontBlock draws glyphs 3 columns wide with full blocks
+	FontBlock = BigFont{fill: '█', scale: 1}
+	// FontWide draws glyphs 6 columns wide with full blocks, for a squarer
+	// look in most terminal fonts
+	FontWide = BigFont{fill: '█', scale: 2}
+	// FontAscii draws glyphs 3 columns wide with '#', for minimal terminals
+	FontAscii = BigFont{fill: '#', scale: 1}
+)
+
+// Width returns the number of columns in each glyph
+func (f BigFont) Width() int {
+	return bigGlyphWidth * max(1, f.scale)
+}
+
+// Height returns the number of rows in each glyph
+func (f BigFont) Height() int {
+	return bigGlyphHeight
+}
+
+// glyph returns the rows of r's glyph, blank if there is none
+func (f BigFont) glyph(r rune) []string {
+	pattern, ok := bigGlyphs[unicode.ToUpper(r)]
+	if !ok {
+		pattern = bigGlyphs[' ']
+	}
+	fill := f.fill
+	if screen.AsciiOnly() && fill > unicode.MaxASCII {
+		fill = '#'
+	}
+	rows := strings.Fields(pattern)
+	for i, row := range rows {
+		var b strings.Builder
+		for _, pixel := range row {
+			cell := ' '
+			if pixel == '#' {
+				cell = fill
+			}
+			for range max(1, f.scale) {
+				b.WriteRune(cell)
+			}
+		}
+		rows[i] = b.String()
+	}
+	return rows
+}
+
+// BigText draws a line of text in a large banner font, e.g. a headline
+// number on a dashboard
+type BigText struct {
+	BaseWidget
+	text      string
+	font      BigFont
+	style     terminal.Style
+	alignment layout.Alignment
+}
+
+// NewBigText creates a banner of text in FontBlock
+func NewBigText(text string) *BigText {
+	return &BigText{
+		BaseWidget: NewBaseWidget(),
+		text:       text,
+		font:       FontBlock,
+		style:      terminal.DefaultStyle(),
+		alignment:  layout.AlignStart,
+	}
+}
+
+// SetText sets the text
+func (t *BigText) SetText(text string) *BigText {
+	t.text = text
+	return t
+}
+
+// Text returns the text
+func (t *BigText) Text() string {
+	return t.text
+}
+
+// SetFont sets the font
+func (t *BigText) SetFont(font BigFont) *BigText {
+	t.font = font
+	return t
+}
+
+// SetStyle sets the style
+func (t *BigText) SetStyle(style terminal.Style) *BigText {
+	t.style = style
+	return t
+}
+
+// SetAlignment sets how the banner is positioned within wider bounds
+func (t *BigText) SetAlignment(alignment layout.Alignment) *BigText {
+	t.alignment = alignment
+	return t
+}
+
+// Render draws the banner, a glyph per character with a column between
+// them, clipped to bounds
+func (t *BigText) Render(buf *screen.Buffer, bounds layout.Rect) {
+	t.bounds = bounds
+	if !t.visible || bounds.IsEmpty() {
+		return
+	}
+
+	size := t.Size()
+	x := bounds.X + layout.Align(size.Width, bounds.Width, t.alignment)
+	right := bounds.X + bounds.Width
+	for _, r := range t.text {
+		if x >= right {
+			break
+		}
+		for row, line := range t.font.glyph(r) {
+			if row >= bounds.Height {
+				break
+			}
+			buf.DrawStringClipped(x, bounds.Y+row, bounds.Z, line, t.style, right-x)
+		}
+		x += t.font.Width() + 1
+	}
+}
+
+// HandleEvent does nothing; banners are display only
+func (t *BigText) HandleEvent(event input.Event) bool {
+	return false
+}
+
+// Size returns the size of the whole banner
+func (t *BigText) Size() layout.Size {
+	n := len([]rune(t.text))
+	if n == 0 {
+		return layout.NewSize(0, t.font.Height())
+	}
+	return layout.NewSize(n*(t.font.Width()+1)-1, t.font.Height())
+}
+
+// MinSize returns the size of one glyph
+func (t *BigText) MinSize() layout.Size {
+	return layout.NewSize(t.font.Width(), t.font.Height())
+}
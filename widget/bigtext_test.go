@@ -0,0 +1,58 @@
+package widget
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/layout"
+)
+
+func TestBigTextRender(t *testing.T) {
+	bt := NewBigText("12").SetFont(FontAscii)
+	if got, want := bt.Size(), layout.NewSize(7, 5); got != want {
+		t.Fatalf("Size() = %v, want %v", got, want)
+	}
+	want := []string{
+		" #  ###",
+		"##    #",
+		" #  ###",
+		" #  #",
+		"### ###",
+	}
+	lines := strings.Split(renderWidget(bt, 7, 5).ToString(), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " ")
+	}
+	if !slices.Equal(lines, want) {
+		t.Errorf("rendered\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestBigFontGlyphWidths(t *testing.T) {
+	for _, font := range []BigFont{FontBlock, FontWide, FontAscii} {
+		for r := range bigGlyphs {
+			rows := font.glyph(r)
+			if len(rows) != font.Height() {
+				t.Errorf("glyph %q has %d rows, want %d", r, len(rows), font.Height())
+			}
+			for _, row := range rows {
+				if n := len([]rune(row)); n != font.Width() {
+					t.Errorf("glyph %q row %q is %d wide, want %d", r, row, n, font.Width())
+				}
+			}
+		}
+	}
+	if got, want := NewBigText("42").SetFont(FontWide).Size(), layout.NewSize(13, 5); got != want {
+		t.Errorf("FontWide Size() = %v, want %v", got, want)
+	}
+}
+
+func TestBigFontUnknownAndLowercase(t *testing.T) {
+	if got, want := FontAscii.glyph('@'), FontAscii.glyph(' '); !slices.Equal(got, want) {
+		t.Errorf("unknown glyph = %q, want blank %q", got, want)
+	}
+	if got, want := FontAscii.glyph('a'), FontAscii.glyph('A'); !slices.Equal(got, want) {
+		t.Errorf("glyph 'a' = %q, want the glyph for 'A' %q", got, want)
+	}
+}
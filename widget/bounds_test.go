@@ -0,0 +1,87 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// coreWidgets returns one of each core widget, with content and focused
+func coreWidgets() map[string]Widget {
+	form := NewForm().SetTitle("Form").SetShowBorder(true)
+	form.AddTextInput("Name", "name").SetValue("Ada")
+	form.AddSubmitButton("OK")
+	log := NewLogView()
+	log.Append("line one")
+	chart := NewChart().AddSeries("s", []float64{1, 3, 2}, terminal.DefaultStyle())
+	wizard := NewWizard().AddStep("One", NewText("first"), nil).AddStep("Two", NewText("second"), nil)
+	menu := NewMenu().SetItems([]*MenuItem{{Label: "Open"}, {Label: "Save"}})
+	palette := NewCommandPalette().SetCommands([]Command{{Name: "Quit"}})
+	crumbs := NewBreadcrumb().Push("Home", nil).Push("Docs", nil)
+	accordion := NewAccordion().AddSection("Section", NewText("body"))
+
+	widgets := map[string]Widget{
+		"Accordion":        accordion,
+		"BigText":          NewBigText("42"),
+		"Breadcrumb":       crumbs,
+		"Button":           NewButton("OK"),
+		"Canvas":           NewCanvas(4, 4),
+		"Chart":            chart,
+		"Form":             form,
+		"Heatmap":          NewHeatmap([][]float64{{1, 2}, {3, 4}}),
+		"List":             NewList().SetStrings([]string{"one", "two"}).SetShowBorder(true),
+		"LogView":          log,
+		"Menu":             menu,
+		"CommandPalette":   palette,
+		"Progress":         NewProgress().SetValue(0.5),
+		"Spinner":          NewSpinner(),
+		"ScrollView":       NewScrollView(NewText("a\nb\nc\nd")),
+		"Search":           NewSearch().SetValue("query").SetHelpItems([]string{"help"}),
+		"SearchAndResults": newTestSearchAndResults(5),
+		"SecureTextInput":  NewSecureTextInput(),
+		"SplitPane":        NewSplitPane(layout.Horizontal, NewText("left"), NewText("right")),
+		"Table":            NewTable().SetColumns([]TableColumn{{Title: "A", Width: 4}, {Title: "B", Flex: 1}}).SetRows([][]string{{"1", "2"}}),
+		"Text":             NewText("hello world"),
+		"TextArea":         NewTextArea().SetValue("one\ntwo"),
+		"TextInput":        NewTextInput().SetValue("value"),
+		"UnsavedGuard":     NewUnsavedGuard(NewText("doc")),
+		"Wizard":           wizard,
+	}
+	for _, w := range widgets {
+		w.SetFocused(true)
+	}
+	return widgets
+}
+
+func TestRenderTinyBounds(t *testing.T) {
+	for _, size := range []layout.Size{layout.NewSize(0, 0), layout.NewSize(1, 1), layout.NewSize(0, 3), layout.NewSize(3, 0), layout.NewSize(2, 2)} {
+		for name, w := range coreWidgets() {
+			buf := screen.NewBuffer(6, 6, screen.DefaultDepth)
+			buf.Fill(screen.NewCell('.', terminal.DefaultStyle()))
+			bounds := layout.NewRect(2, 2, 0, size.Width, size.Height)
+
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Errorf("%s: Render into %dx%d panicked: %v", name, size.Width, size.Height, r)
+					}
+				}()
+				w.Render(buf, bounds)
+			}()
+
+			for y := range 6 {
+				for x := range 6 {
+					if bounds.Contains(x, y) {
+						continue
+					}
+					if got := buf.Get(x, y, 0).Rune; got != '.' {
+						t.Errorf("%s: Render into %dx%d wrote %q at (%d, %d), outside its bounds",
+							name, size.Width, size.Height, got, x, y)
+					}
+				}
+			}
+		}
+	}
+}
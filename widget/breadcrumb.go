@@ -0,0 +1,260 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// breadcrumbEllipsis stands in for the crumbs left out when the path is
+// too long to show
+const breadcrumbEllipsis = "…"
+
+// Crumb is one step of a Breadcrumb path
+type Crumb struct {
+	Label string
+	Value interface{}
+}
+
+// crumbSpan is where a crumb was drawn, relative to the bounds
+type crumbSpan struct {
+	index int
+	x     int
+	width int
+}
+
+// Breadcrumb shows a path of crumbs joined by a separator, such as the
+// folders leading to the current one
+// When the path is too wide, crumbs after the first are replaced with an
+// ellipsis, keeping as many of the last ones as fit. Left/Right move between
+// crumbs and Enter or a click selects one.
+type Breadcrumb struct {
+	BaseWidget
+	crumbs         []Crumb
+	separator      string
+	cursor         int
+	spans          []crumbSpan // From the last render
+	style          terminal.Style
+	currentStyle   terminal.Style
+	cursorStyle    terminal.Style
+	separatorStyle terminal.Style
+	onSelect       func(index int)
+}
+
+// NewBreadcrumb creates an empty breadcrumb
+func NewBreadcrumb() *Breadcrumb {
+	b := &Breadcrumb{
+		BaseWidget:     NewBaseWidget(),
+		separator:      " › ",
+		style:          terminal.DefaultStyle(),
+		currentStyle:   terminal.DefaultStyle().WithBold(),
+		cursorStyle:    terminal.DefaultStyle().WithReverse(),
+		separatorStyle: terminal.DefaultStyle().WithDim(),
+	}
+	b.SetInteractive(true)
+	return b
+}
+
+// SetCrumbs sets the path, moving the cursor to the last crumb
+func (b *Breadcrumb) SetCrumbs(crumbs []Crumb) *Breadcrumb {
+	b.crumbs = crumbs
+	b.cursor = max(0, len(crumbs)-1)
+	return b
+}
+
+// Crumbs returns the path
+func (b *Breadcrumb) Crumbs() []Crumb {
+	return b.crumbs
+}
+
+// Push appends a crumb, moving the cursor to it
+func (b *Breadcrumb) Push(label string, value interface{}) *Breadcrumb {
+	return b.SetCrumbs(append(b.crumbs, Crumb{Label: label, Value: value}))
+}
+
+// Cursor returns the index of the crumb under the cursor
+func (b *Breadcrumb) Cursor() int {
+	return b.cursor
+}
+
+// SetSeparator sets the text drawn between crumbs
+func (b *Breadcrumb) SetSeparator(separator string) *Breadcrumb {
+	b.separator = separator
+	return b
+}
+
+// SetStyle sets the style of the crumbs
+func (b *Breadcrumb) SetStyle(style terminal.Style) *Breadcrumb {
+	b.style = style
+	return b
+}
+
+// SetCurrentStyle sets the style of the last crumb
+func (b *Breadcrumb) SetCurrentStyle(style terminal.Style) *Breadcrumb {
+	b.currentStyle = style
+	return b
+}
+
+// SetCursorStyle sets the style of the crumb under the cursor while focused
+func (b *Breadcrumb) SetCursorStyle(style terminal.Style) *Breadcrumb {
+	b.cursorStyle = style
+	return b
+}
+
+// SetSeparatorStyle sets the style of the separators
+func (b *Breadcrumb) SetSeparatorStyle(style terminal.Style) *Breadcrumb {
+	b.separatorStyle = style
+	return b
+}
+
+// OnSelect sets the callback for a crumb being selected
+func (b *Breadcrumb) OnSelect(fn func(index int)) *Breadcrumb {
+	b.onSelect = fn
+	return b
+}
+
+// shown returns the indexes of the crumbs that fit in width, with -1 for
+// the ellipsis standing in for the rest
+func (b *Breadcrumb) shown(width int) []int {
+	n := len(b.crumbs)
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	if b.pathWidth(all) <= width || n <= 2 {
+		return all
+	}
+	// Keep the first crumb and as many of the last ones as fit
+	for keep := n - 2; keep >= 1; keep-- {
+		shown := append([]int{0, -1}, all[n-keep:]...)
+		if b.pathWidth(shown) <= width {
+			return shown
+		}
+	}
+	return []int{-1, n - 1}
+}
+
+// pathWidth returns the width of the crumbs at indexes joined by separators
+func (b *Breadcrumb) pathWidth(indexes []int) int {
+	width := 0
+	for i, index := range indexes {
+		if i > 0 {
+			width += screen.DisplayWidth(b.separator)
+		}
+		if index < 0 {
+			width += screen.DisplayWidth(breadcrumbEllipsis)
+		} else {
+			width += screen.DisplayWidth(b.crumbs[index].Label)
+		}
+	}
+	return width
+}
+
+// Render draws the path
+func (b *Breadcrumb) Render(buf *screen.Buffer, bounds layout.Rect) {
+	b.bounds = bounds
+	b.spans = b.spans[:0]
+	if !b.visible || bounds.IsEmpty() {
+		return
+	}
+
+	x := 0
+	for i, index := range b.shown(bounds.Width) {
+		if i > 0 {
+			x += b.draw(buf, bounds, x, b.separator, b.separatorStyle)
+		}
+		if index < 0 {
+			x += b.draw(buf, bounds, x, breadcrumbEllipsis, b.separatorStyle)
+			continue
+		}
+		style := b.style
+		if index == len(b.crumbs)-1 {
+			style = b.currentStyle
+		}
+		if index == b.cursor && b.focused {
+			style = b.cursorStyle
+		}
+		width := b.draw(buf, bounds, x, b.crumbs[index].Label, style)
+		b.spans = append(b.spans, crumbSpan{index: index, x: x, width: width})
+		x += width
+	}
+}
+
+// draw draws s at x within bounds, truncated to the space left
+// Returns the width drawn
+func (b *Breadcrumb) draw(buf *screen.Buffer, bounds layout.Rect, x int, s string, style terminal.Style) int {
+	if x >= bounds.Width {
+		return 0
+	}
+	s = screen.Truncate(s, bounds.Width-x, true)
+	buf.DrawString(bounds.X+x, bounds.Y, bounds.Z, s, style)
+	return screen.DisplayWidth(s)
+}
+
+// HandleEvent handles input events
+func (b *Breadcrumb) HandleEvent(event input.Event) bool {
+	if e, ok := leftPress(event); ok {
+		for _, span := range b.spans {
+			if e.Y == 0 && e.X >= span.x && e.X < span.x+span.width {
+				b.Select(span.index)
+				return true
+			}
+		}
+		return false
+	}
+	if !b.focused {
+		return false
+	}
+
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok {
+		return false
+	}
+
+	switch keyEvent.Key {
+	case input.KeyLeft:
+		b.cursor = max(0, b.cursor-1)
+		return true
+	case input.KeyRight:
+		b.cursor = min(len(b.crumbs)-1, b.cursor+1)
+		return true
+	case input.KeyHome:
+		b.cursor = 0
+		return true
+	case input.KeyEnd:
+		b.cursor = max(0, len(b.crumbs)-1)
+		return true
+	case input.KeyEnter:
+		b.Select(b.cursor)
+		return true
+	}
+	return false
+}
+
+// Select moves the cursor to the crumb at index and reports it to the
+// select callback
+func (b *Breadcrumb) Select(index int) *Breadcrumb {
+	if index < 0 || index >= len(b.crumbs) {
+		return b
+	}
+	b.cursor = index
+	if b.onSelect != nil {
+		b.onSelect(index)
+	}
+	return b
+}
+
+// Size returns the preferred size
+func (b *Breadcrumb) Size() layout.Size {
+	all := make([]int, len(b.crumbs))
+	for i := range all {
+		all[i] = i
+	}
+	return layout.NewSize(b.pathWidth(all), 1)
+}
+
+// MinSize returns the minimum size
+func (b *Breadcrumb) MinSize() layout.Size {
+	return layout.NewSize(1, 1)
+}
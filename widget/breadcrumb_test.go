@@ -0,0 +1,63 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// newTestBreadcrumb returns a breadcrumb for /home/user/projects/gotui/widget
+func newTestBreadcrumb() *Breadcrumb {
+	b := NewBreadcrumb()
+	for _, label := range []string{"home", "user", "projects", "gotui", "widget"} {
+		b.Push(label, nil)
+	}
+	return b
+}
+
+func TestBreadcrumbTruncatesMiddle(t *testing.T) {
+	tests := []struct {
+		width int
+		want  string
+	}{
+		{40, "home › user › projects › gotui › widget"},
+		{30, "home › … › gotui › widget"},
+		{20, "home › … › widget"},
+		{12, "… › widget"},
+		{8, "… › wid…"},
+	}
+	for _, tt := range tests {
+		if got := renderWidget(newTestBreadcrumb(), tt.width, 1).ToString(); got != tt.want {
+			t.Errorf("width %d rendered %q, want %q", tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestBreadcrumbSelect(t *testing.T) {
+	b := newTestBreadcrumb()
+	var selected []int
+	b.OnSelect(func(index int) { selected = append(selected, index) })
+
+	b.Select(1).Select(9)
+	if len(selected) != 1 || selected[0] != 1 || b.Cursor() != 1 {
+		t.Fatalf("Select(1), Select(9) reported %v with cursor %d, want [1] and 1", selected, b.Cursor())
+	}
+
+	// "home › … › gotui › widget": gotui starts at column 11
+	renderWidget(b, 30, 1)
+	if !b.HandleEvent(leftClick(12, 0, false)) || selected[len(selected)-1] != 3 {
+		t.Errorf("click on gotui selected %v, want 3", selected)
+	}
+	if b.HandleEvent(leftClick(7, 0, false)) {
+		t.Error("click on the ellipsis selected a crumb")
+	}
+
+	b.SetFocused(true)
+	b.HandleEvent(input.KeyEvent{Key: input.KeyEnd})
+	b.HandleEvent(input.KeyEvent{Key: input.KeyLeft})
+	b.HandleEvent(input.KeyEvent{Key: input.KeyLeft})
+	b.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if got := selected[len(selected)-1]; got != 2 {
+		t.Errorf("End, Left, Left, Enter selected %d, want 2", got)
+	}
+}
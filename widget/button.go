@@ -1,6 +1,9 @@
 package widget
 
 import (
+	"strings"
+	"unicode"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
@@ -15,6 +18,11 @@ type Button struct {
 	style        terminal.Style
 	focusedStyle terminal.Style
 	width        int
+	hotkey       rune // Activates the button, 0 for none
+	brackets     bool // Draw focus as "› Label ‹"
+	toggle       bool // Pressing flips on instead of calling onPress
+	on           bool
+	onToggle     func(bool)
 }
 
 // NewButton creates a new button with the given label
@@ -58,6 +66,109 @@ func (b *Button) SetFocusedStyle(style terminal.Style) *Button {
 	return b
 }
 
+// SetHotkey sets a letter that presses the button, with or without Alt,
+// even when it isn't focused; 0 removes it
+// The first matching letter of the label is underlined.
+func (b *Button) SetHotkey(r rune) *Button {
+	b.hotkey = r
+	return b
+}
+
+// Hotkey returns the hotkey, or 0 if there is none
+func (b *Button) Hotkey() rune {
+	return b.hotkey
+}
+
+// MatchesHotkey checks if e is the button's hotkey, ignoring case and Alt
+func (b *Button) MatchesHotkey(e input.KeyEvent) bool {
+	if b.hotkey == 0 || e.Key != input.KeyRune || e.Modifier&^input.ModAlt != 0 {
+		return false
+	}
+	return unicode.ToLower(e.Rune) == unicode.ToLower(b.hotkey)
+}
+
+// SetFocusBrackets draws the focused button as "› Label ‹" instead of
+// "[ Label ]", so focus shows without relying on the focused style
+func (b *Button) SetFocusBrackets(show bool) *Button {
+	b.brackets = show
+	return b
+}
+
+// SetToggle makes pressing the button flip it on and off, drawn as
+// "[x] Label" or "[ ] Label", calling OnToggle instead of OnPress
+// Toggle buttons show focus by style only, ignoring SetFocusBrackets.
+func (b *Button) SetToggle(toggle bool) *Button {
+	b.toggle = toggle
+	return b
+}
+
+// IsToggle returns true if the button is a toggle
+func (b *Button) IsToggle() bool {
+	return b.toggle
+}
+
+// SetOn sets whether a toggle button is on, without calling OnToggle
+func (b *Button) SetOn(on bool) *Button {
+	b.on = on
+	return b
+}
+
+// IsOn returns true if a toggle button is on
+func (b *Button) IsOn() bool {
+	return b.on
+}
+
+// OnToggle sets the callback for when a toggle button is flipped
+func (b *Button) OnToggle(fn func(on bool)) *Button {
+	b.onToggle = fn
+	return b
+}
+
+// press flips a toggle button, or calls the press callback
+func (b *Button) press() {
+	if b.toggle {
+		b.on = !b.on
+		if b.onToggle != nil {
+			b.onToggle(b.on)
+		}
+		return
+	}
+	if b.onPress != nil {
+		b.onPress()
+	}
+}
+
+// text returns the button as drawn, and the rune index where the label
+// starts
+func (b *Button) text() (string, int) {
+	if b.toggle {
+		if b.on {
+			return "[x] " + b.label, 4
+		}
+		return "[ ] " + b.label, 4
+	}
+	if b.focused && b.brackets {
+		return "› " + b.label + " ‹", 2
+	}
+	return "[ " + b.label + " ]", 2
+}
+
+// hotkeyIndex returns the rune index of the hotkey in the label, or -1
+func (b *Button) hotkeyIndex() int {
+	if b.hotkey == 0 {
+		return -1
+	}
+	target := unicode.ToLower(b.hotkey)
+	i := 0
+	for _, r := range b.label {
+		if unicode.ToLower(r) == target {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
 // SetWidth sets a fixed width for the button
 func (b *Button) SetWidth(width int) *Button {
 	b.width = width
@@ -66,7 +177,8 @@ func (b *Button) SetWidth(width int) *Button {
 
 // Render draws the button
 func (b *Button) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !b.visible {
+	b.bounds = bounds
+	if !b.visible || bounds.IsEmpty() {
 		return
 	}
 
@@ -76,46 +188,49 @@ func (b *Button) Render(buf *screen.Buffer, bounds layout.Rect) {
 	}
 
 	// Format: [ Label ]
-	text := "[ " + b.label + " ]"
-	
+	text, labelStart := b.text()
+	width := screen.DisplayWidth(text)
+
 	// Pad to width if specified
-	if b.width > 0 && len(text) < b.width {
-		padding := b.width - len(text)
-		leftPad := padding / 2
-		rightPad := padding - leftPad
-		for i := 0; i < leftPad; i++ {
-			text = " " + text
-		}
-		for i := 0; i < rightPad; i++ {
-			text = text + " "
-		}
+	leftPad := 0
+	if b.width > 0 && width < b.width {
+		padding := b.width - width
+		leftPad = padding / 2
+		text = strings.Repeat(" ", leftPad) + text + strings.Repeat(" ", padding-leftPad)
+		width = b.width
 	}
 
 	// Center in bounds if text is shorter than bounds width
 	x := bounds.X
-	if len(text) < bounds.Width {
-		x = bounds.X + (bounds.Width-len(text))/2
+	if width < bounds.Width {
+		x = bounds.X + (bounds.Width-width)/2
 	}
 
-	buf.DrawString(x, bounds.Y, bounds.Z, text, style)
+	var positions []int
+	if i := b.hotkeyIndex(); i >= 0 {
+		positions = []int{leftPad + labelStart + i}
+	}
+	buf.DrawStringHighlighted(x, bounds.Y, bounds.Z, text, style, style.WithUnderline(), positions, min(width, bounds.Width))
 }
 
 // HandleEvent handles input events
 func (b *Button) HandleEvent(event input.Event) bool {
-	if !b.focused {
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok || !b.visible {
 		return false
 	}
 
-	keyEvent, ok := event.(input.KeyEvent)
-	if !ok {
+	if b.MatchesHotkey(keyEvent) {
+		b.press()
+		return true
+	}
+	if !b.focused {
 		return false
 	}
 
 	// Trigger on Enter or Space
 	if keyEvent.Key == input.KeyEnter || (keyEvent.Key == input.KeyRune && keyEvent.Rune == ' ') {
-		if b.onPress != nil {
-			b.onPress()
-		}
+		b.press()
 		return true
 	}
 
@@ -124,7 +239,7 @@ func (b *Button) HandleEvent(event input.Event) bool {
 
 // Size returns the preferred size
 func (b *Button) Size() layout.Size {
-	width := len(b.label) + 4 // "[ " + label + " ]"
+	width := screen.DisplayWidth(b.label) + 4 // "[ " + label + " ]" or "[x] " + label
 	if b.width > 0 {
 		width = b.width
 	}
@@ -133,6 +248,17 @@ func (b *Button) Size() layout.Size {
 
 // MinSize returns the minimum size
 func (b *Button) MinSize() layout.Size {
-	return layout.NewSize(len(b.label)+4, 1)
+	return layout.NewSize(screen.DisplayWidth(b.label)+4, 1)
 }
 
+// AccessibleDescription describes the button, and whether it is on if it
+// is a toggle
+func (b *Button) AccessibleDescription() string {
+	desc := "Button " + quote(b.label)
+	if b.toggle && b.on {
+		desc += " [on]"
+	} else if b.toggle {
+		desc += " [off]"
+	}
+	return desc + focusState(b)
+}
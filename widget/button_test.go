@@ -0,0 +1,119 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestButtonHotkeyActivates(t *testing.T) {
+	pressed := 0
+	b := NewButton("Save").SetHotkey('s').OnPress(func() { pressed++ })
+
+	tests := []struct {
+		name string
+		key  input.KeyEvent
+		want bool
+	}{
+		{"letter", input.KeyEvent{Key: input.KeyRune, Rune: 's'}, true},
+		{"other case", input.KeyEvent{Key: input.KeyRune, Rune: 'S'}, true},
+		{"alt", input.KeyEvent{Key: input.KeyRune, Rune: 's', Modifier: input.ModAlt}, true},
+		{"ctrl", input.KeyEvent{Key: input.KeyRune, Rune: 's', Modifier: input.ModCtrl}, false},
+		{"other letter", input.KeyEvent{Key: input.KeyRune, Rune: 'a'}, false},
+	}
+	for _, tt := range tests {
+		pressed = 0
+		// The button is not focused
+		if got := b.HandleEvent(tt.key); got != tt.want || (pressed == 1) != tt.want {
+			t.Errorf("%s: HandleEvent = %v with %d presses, want %v", tt.name, got, pressed, tt.want)
+		}
+	}
+}
+
+func TestButtonHotkeyUnderlined(t *testing.T) {
+	tests := []struct {
+		hotkey rune
+		want   int // Column of the underlined cell, -1 for none
+	}{
+		{'s', 2},
+		{'v', 4}, // First match only
+		{'A', 3}, // Ignoring case
+		{'x', -1},
+	}
+	for _, tt := range tests {
+		b := NewButton("Save").SetHotkey(tt.hotkey)
+		buf := renderWidget(b, 8, 1)
+		if got := buf.ToString(); got != "[ Save ]" {
+			t.Fatalf("render = %q, want %q", got, "[ Save ]")
+		}
+		for x := 0; x < 8; x++ {
+			if underlined := buf.Get(x, 0, 0).Style.Underline; underlined != (x == tt.want) {
+				t.Errorf("hotkey %q: cell %d underlined = %v", tt.hotkey, x, underlined)
+			}
+		}
+	}
+}
+
+func TestButtonFocusBrackets(t *testing.T) {
+	b := NewButton("OK").SetFocusBrackets(true)
+	if got := renderWidget(b, 6, 1).ToString(); got != "[ OK ]" {
+		t.Errorf("unfocused render = %q, want %q", got, "[ OK ]")
+	}
+	b.SetFocused(true)
+	if got := renderWidget(b, 6, 1).ToString(); got != "› OK ‹" {
+		t.Errorf("focused render = %q, want %q", got, "› OK ‹")
+	}
+}
+
+func TestFormButtonHotkey(t *testing.T) {
+	f := NewForm()
+	name := f.AddTextInput("Name", "")
+	pressed := false
+	f.AddButton("Cancel", func() { pressed = true }).SetHotkey('c')
+	f.SetFocused(true)
+
+	// Plain letters type into the focused field; Alt presses the button
+	f.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'c'})
+	if pressed || name.Value() != "c" {
+		t.Fatalf("pressed, value = %v, %q, want false, %q", pressed, name.Value(), "c")
+	}
+	f.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'c', Modifier: input.ModAlt})
+	if !pressed {
+		t.Error("Alt+C did not press the button")
+	}
+}
+
+func TestButtonToggle(t *testing.T) {
+	var toggled []bool
+	pressed := false
+	b := NewButton("Bold").SetToggle(true).
+		OnPress(func() { pressed = true }).
+		OnToggle(func(on bool) { toggled = append(toggled, on) })
+	b.SetFocused(true)
+
+	if got := renderWidget(b, 8, 1).ToString(); got != "[ ] Bold" {
+		t.Errorf("off render = %q, want %q", got, "[ ] Bold")
+	}
+	b.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if !b.IsOn() {
+		t.Error("IsOn() after Enter = false, want true")
+	}
+	if got := renderWidget(b, 8, 1).ToString(); got != "[x] Bold" {
+		t.Errorf("on render = %q, want %q", got, "[x] Bold")
+	}
+	b.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: ' '})
+	if b.IsOn() {
+		t.Error("IsOn() after Space = true, want false")
+	}
+	if len(toggled) != 2 || !toggled[0] || toggled[1] {
+		t.Errorf("OnToggle got %v, want [true false]", toggled)
+	}
+	if pressed {
+		t.Error("OnPress called for a toggle button")
+	}
+
+	b.SetOn(true)
+	if !b.IsOn() || len(toggled) != 2 {
+		t.Errorf("SetOn(true): IsOn() = %v with %d callbacks, want true with 2", b.IsOn(), len(toggled))
+	}
+}
@@ -0,0 +1,238 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// CanvasMode selects how canvas pixels are packed into cells
+type CanvasMode int
+
+const (
+	// CanvasBraille packs 2x4 pixels into each cell using Braille patterns
+	CanvasBraille CanvasMode = iota
+	// CanvasBlocks packs 2x2 pixels into each cell using quadrant blocks
+	CanvasBlocks
+)
+
+// brailleDots are the Braille dot bits for each pixel of a cell, by [y][x]
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// quadrantBlocks are the block glyphs indexed by their lit quadrants:
+// top-left 1, top-right 2, bottom-left 4, bottom-right 8
+var quadrantBlocks = [16]rune{
+	' ', '▘', '▝', '▀', '▖', '▌', '▞', '▛',
+	'▗', '▚', '▐', '▜', '▄', '▙', '▟', '█',
+}
+
+// Canvas is a grid of on/off pixels finer than the cell grid, for plots and
+// simple graphics
+// Each cell takes the pen style of the last pixel turned on within it
+type Canvas struct {
+	BaseWidget
+	width  int // Width in cells
+	height int // Height in cells
+	mode   CanvasMode
+	pixels []bool
+	styles []terminal.Style // Per cell
+	pen    terminal.Style
+	style  terminal.Style
+}
+
+// NewCanvas creates a Braille canvas of width x height cells
+func NewCanvas(width, height int) *Canvas {
+	c := &Canvas{
+		BaseWidget: NewBaseWidget(),
+		mode:       CanvasBraille,
+		pen:        terminal.DefaultStyle(),
+		style:      terminal.DefaultStyle(),
+	}
+	c.Resize(width, height)
+	return c
+}
+
+// SetMode sets how pixels are packed into cells, clearing the canvas since
+// the pixel grid changes size
+func (c *Canvas) SetMode(mode CanvasMode) *Canvas {
+	c.mode = mode
+	c.Resize(c.width, c.height)
+	return c
+}
+
+// Mode returns how pixels are packed into cells
+func (c *Canvas) Mode() CanvasMode {
+	return c.mode
+}
+
+// Resize sets the canvas size in cells, clearing it
+func (c *Canvas) Resize(width, height int) *Canvas {
+	c.width = max(0, width)
+	c.height = max(0, height)
+	pw, ph := c.PixelSize()
+	c.pixels = make([]bool, pw*ph)
+	c.styles = make([]terminal.Style, c.width*c.height)
+	return c
+}
+
+// PixelSize returns the size of the pixel grid
+func (c *Canvas) PixelSize() (int, int) {
+	cw, ch := c.cellPixels()
+	return c.width * cw, c.height * ch
+}
+
+// cellPixels returns how many pixels wide and tall each cell is
+func (c *Canvas) cellPixels() (int, int) {
+	if c.mode == CanvasBlocks {
+		return 2, 2
+	}
+	return 2, 4
+}
+
+// SetPen sets the style of pixels turned on from now on
+func (c *Canvas) SetPen(style terminal.Style) *Canvas {
+	c.pen = style
+	return c
+}
+
+// SetStyle sets the style of cells with no pixels on
+func (c *Canvas) SetStyle(style terminal.Style) *Canvas {
+	c.style = style
+	return c
+}
+
+// Set turns the pixel at (px, py) on or off
+// Pixels outside the canvas are ignored
+func (c *Canvas) Set(px, py int, on bool) *Canvas {
+	pw, ph := c.PixelSize()
+	if px < 0 || py < 0 || px >= pw || py >= ph {
+		return c
+	}
+	c.pixels[py*pw+px] = on
+	if on {
+		cw, ch := c.cellPixels()
+		c.styles[(py/ch)*c.width+px/cw] = c.pen
+	}
+	return c
+}
+
+// Get returns whether the pixel at (px, py) is on
+func (c *Canvas) Get(px, py int) bool {
+	pw, ph := c.PixelSize()
+	if px < 0 || py < 0 || px >= pw || py >= ph {
+		return false
+	}
+	return c.pixels[py*pw+px]
+}
+
+// Line turns on the pixels of a straight line from (x0, y0) to (x1, y1)
+// Parts of the line outside the canvas are skipped
+func (c *Canvas) Line(x0, y0, x1, y1 int) *Canvas {
+	dx, dy := x1-x0, -(y1 - y0)
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy > 0 {
+		dy = -dy
+	}
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	// Bresenham's algorithm
+	err := dx + dy
+	for {
+		c.Set(x0, y0, true)
+		if x0 == x1 && y0 == y1 {
+			return c
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// Clear turns every pixel off
+func (c *Canvas) Clear() *Canvas {
+	clear(c.pixels)
+	return c
+}
+
+// glyph returns the character for the cell at (cx, cy)
+func (c *Canvas) glyph(cx, cy int) rune {
+	pw, _ := c.PixelSize()
+	cw, ch := c.cellPixels()
+	var bits rune
+	for y := 0; y < ch; y++ {
+		for x := 0; x < cw; x++ {
+			if !c.pixels[(cy*ch+y)*pw+cx*cw+x] {
+				continue
+			}
+			if c.mode == CanvasBlocks {
+				bits |= 1 << (y*2 + x)
+			} else {
+				bits |= brailleDots[y][x]
+			}
+		}
+	}
+	switch {
+	case bits == 0:
+		return ' '
+	case c.mode == CanvasBlocks:
+		return quadrantBlocks[bits]
+	default:
+		return 0x2800 + bits
+	}
+}
+
+// Render draws the canvas from the top-left of bounds, clipped to bounds
+func (c *Canvas) Render(buf *screen.Buffer, bounds layout.Rect) {
+	c.bounds = bounds
+	if !c.visible || bounds.IsEmpty() {
+		return
+	}
+
+	width := min(c.width, bounds.Width)
+	height := min(c.height, bounds.Height)
+	for cy := 0; cy < height; cy++ {
+		for cx := 0; cx < width; cx++ {
+			ch := c.glyph(cx, cy)
+			style := c.style
+			if ch != ' ' {
+				style = c.styles[cy*c.width+cx]
+			}
+			buf.Set(bounds.X+cx, bounds.Y+cy, bounds.Z, screen.NewCell(ch, style))
+		}
+	}
+}
+
+// HandleEvent handles input events
+func (c *Canvas) HandleEvent(event input.Event) bool {
+	return false
+}
+
+// Size returns the preferred size
+func (c *Canvas) Size() layout.Size {
+	return layout.NewSize(c.width, c.height)
+}
+
+// MinSize returns the minimum size
+func (c *Canvas) MinSize() layout.Size {
+	return layout.NewSize(1, 1)
+}
@@ -0,0 +1,44 @@
+package widget
+
+import "testing"
+
+func TestCanvasDiagonalBraille(t *testing.T) {
+	c := NewCanvas(2, 1).Line(0, 0, 3, 3)
+	if got := renderWidget(c, 2, 1).ToString(); got != "⠑⢄" {
+		t.Errorf("diagonal rendered %q, want %q", got, "⠑⢄")
+	}
+	if pw, ph := c.PixelSize(); pw != 4 || ph != 4 {
+		t.Errorf("PixelSize() = %d, %d, want 4, 4", pw, ph)
+	}
+}
+
+func TestCanvasDiagonalBlocks(t *testing.T) {
+	c := NewCanvas(2, 2).SetMode(CanvasBlocks).Line(0, 0, 3, 3)
+	if got := renderWidget(c, 2, 2).ToString(); got != "▚\n ▚" {
+		t.Errorf("diagonal rendered %q, want %q", got, "▚\n ▚")
+	}
+	c.Clear()
+	if got := renderWidget(c, 2, 2).ToString(); got != "\n" {
+		t.Errorf("cleared canvas rendered %q, want blank", got)
+	}
+}
+
+func TestCanvasIgnoresOutOfRangePixels(t *testing.T) {
+	c := NewCanvas(1, 1)
+	c.Set(-1, 0, true).Set(0, -1, true).Set(2, 0, true).Set(0, 4, true)
+	if got := renderWidget(c, 1, 1).ToString(); got != "" {
+		t.Errorf("out-of-range pixels rendered %q, want nothing", got)
+	}
+	if c.Get(2, 0) || c.Get(-1, 0) {
+		t.Error("Get outside the canvas = true, want false")
+	}
+
+	// A line starting off the canvas draws only its part on it
+	c.Line(-3, -3, 1, 1)
+	if !c.Get(0, 0) || !c.Get(1, 1) {
+		t.Error("the visible part of the line was not drawn")
+	}
+	if got := renderWidget(c, 1, 1).ToString(); got != "⠑" {
+		t.Errorf("clipped line rendered %q, want %q", got, "⠑")
+	}
+}
@@ -0,0 +1,269 @@
+package widget
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// chartGridLines is how many horizontal gridlines divide the plot
+const chartGridLines = 4
+
+// ChartSeries is a named line of values plotted by a Chart
+type ChartSeries struct {
+	Name  string
+	Data  []float64
+	Style terminal.Style
+}
+
+// Chart plots one or more series as lines on a Canvas
+// Values are spaced evenly along the x axis by index, so series of
+// different lengths share the scale of the longest one. The y axis fits
+// the data unless SetYRange fixes it; NaN values leave a gap in the line.
+type Chart struct {
+	BaseWidget
+	series    []ChartSeries
+	canvas    *Canvas
+	yMin      float64
+	yMax      float64
+	autoY     bool
+	showAxes  bool
+	showGrid  bool
+	width     int
+	height    int
+	axisStyle terminal.Style
+	gridStyle terminal.Style
+}
+
+// NewChart creates a chart with axes and an automatic y range
+func NewChart() *Chart {
+	return &Chart{
+		BaseWidget: NewBaseWidget(),
+		canvas:     NewCanvas(0, 0),
+		autoY:      true,
+		showAxes:   true,
+		width:      40,
+		height:     10,
+		axisStyle:  terminal.DefaultStyle(),
+		gridStyle:  terminal.DefaultStyle().WithDim(),
+	}
+}
+
+// AddSeries adds a series drawn in style
+func (c *Chart) AddSeries(name string, data []float64, style terminal.Style) *Chart {
+	c.series = append(c.series, ChartSeries{Name: name, Data: data, Style: style})
+	return c
+}
+
+// ClearSeries removes all series
+func (c *Chart) ClearSeries() *Chart {
+	c.series = nil
+	return c
+}
+
+// Series returns the series
+func (c *Chart) Series() []ChartSeries {
+	return c.series
+}
+
+// SetYRange fixes the y axis range; values outside it are clipped
+func (c *Chart) SetYRange(min, max float64) *Chart {
+	c.yMin, c.yMax = min, max
+	c.autoY = false
+	return c
+}
+
+// AutoYRange fits the y axis to the data again
+func (c *Chart) AutoYRange() *Chart {
+	c.autoY = true
+	return c
+}
+
+// YRange returns the y axis range
+// An automatic range spans the data, widened when all values are equal;
+// with no data it is 0 to 1
+func (c *Chart) YRange() (float64, float64) {
+	if !c.autoY {
+		return c.yMin, c.yMax
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, s := range c.series {
+		for _, v := range s.Data {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			lo = math.Min(lo, v)
+			hi = math.Max(hi, v)
+		}
+	}
+	switch {
+	case lo > hi:
+		return 0, 1
+	case lo == hi:
+		return lo - 1, hi + 1
+	}
+	return lo, hi
+}
+
+// SetShowAxes enables or disables the axes and their labels
+func (c *Chart) SetShowAxes(show bool) *Chart {
+	c.showAxes = show
+	return c
+}
+
+// SetShowGrid enables or disables horizontal gridlines
+func (c *Chart) SetShowGrid(show bool) *Chart {
+	c.showGrid = show
+	return c
+}
+
+// SetMode sets how the plot's pixels are packed into cells
+func (c *Chart) SetMode(mode CanvasMode) *Chart {
+	c.canvas.SetMode(mode)
+	return c
+}
+
+// SetSize sets the preferred size
+func (c *Chart) SetSize(width, height int) *Chart {
+	c.width = width
+	c.height = height
+	return c
+}
+
+// SetAxisStyle sets the style of the axes and labels
+func (c *Chart) SetAxisStyle(style terminal.Style) *Chart {
+	c.axisStyle = style
+	return c
+}
+
+// SetGridStyle sets the style of the gridlines
+func (c *Chart) SetGridStyle(style terminal.Style) *Chart {
+	c.gridStyle = style
+	return c
+}
+
+// points returns the number of x positions, the length of the longest series
+func (c *Chart) points() int {
+	n := 0
+	for _, s := range c.series {
+		n = max(n, len(s.Data))
+	}
+	return n
+}
+
+// toPixel maps the value v at index i onto a pixel grid of pw x ph,
+// given n x positions and the y range lo to hi
+func toPixel(i int, v float64, n, pw, ph int, lo, hi float64) (int, int) {
+	x := 0
+	if n > 1 {
+		x = int(math.Round(float64(i) * float64(pw-1) / float64(n-1)))
+	}
+	y := ph - 1 - int(math.Round((v-lo)/(hi-lo)*float64(ph-1)))
+	return x, y
+}
+
+// formatAxisValue formats a value for an axis label
+func formatAxisValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
+
+// Render draws the axes, gridlines and series
+func (c *Chart) Render(buf *screen.Buffer, bounds layout.Rect) {
+	c.bounds = bounds
+	if !c.visible || bounds.IsEmpty() {
+		return
+	}
+
+	lo, hi := c.YRange()
+	plot := bounds
+	if c.showAxes {
+		plot = c.drawAxes(buf, bounds, lo, hi)
+	}
+	if plot.IsEmpty() {
+		return
+	}
+
+	c.canvas.Resize(plot.Width, plot.Height)
+	pw, ph := c.canvas.PixelSize()
+	if c.showGrid {
+		c.canvas.SetPen(c.gridStyle)
+		for line := 1; line < chartGridLines; line++ {
+			py := line * (ph - 1) / chartGridLines
+			for px := 0; px < pw; px += 4 {
+				c.canvas.Set(px, py, true)
+			}
+		}
+	}
+
+	n := c.points()
+	for _, s := range c.series {
+		c.canvas.SetPen(s.Style)
+		prevX, prevY, hasPrev := 0, 0, false
+		for i, v := range s.Data {
+			if math.IsNaN(v) {
+				hasPrev = false
+				continue
+			}
+			x, y := toPixel(i, v, n, pw, ph, lo, hi)
+			if hasPrev {
+				c.canvas.Line(prevX, prevY, x, y)
+			} else {
+				c.canvas.Set(x, y, true)
+			}
+			prevX, prevY, hasPrev = x, y, true
+		}
+	}
+	c.canvas.Render(buf, plot)
+}
+
+// drawAxes draws the y axis with its range labels on the left and the x
+// axis with the first and last index below, returning the plot area
+func (c *Chart) drawAxes(buf *screen.Buffer, bounds layout.Rect, lo, hi float64) layout.Rect {
+	top, bottom := formatAxisValue(hi), formatAxisValue(lo)
+	labelWidth := max(screen.DisplayWidth(top), screen.DisplayWidth(bottom))
+	plot := bounds.Inset(0, 0, 2, labelWidth+1)
+	if plot.IsEmpty() {
+		return plot
+	}
+
+	borders := screen.DefaultBorders()
+	axisX := plot.X - 1
+	axisY := plot.Y + plot.Height
+	buf.DrawVLine(axisX, plot.Y, bounds.Z, plot.Height, borders.Vertical, c.axisStyle)
+	buf.Set(axisX, axisY, bounds.Z, screen.NewCell(borders.BottomLeft, c.axisStyle))
+	buf.DrawHLine(plot.X, axisY, bounds.Z, plot.Width, borders.Horizontal, c.axisStyle)
+
+	buf.DrawString(axisX-screen.DisplayWidth(top), plot.Y, bounds.Z, top, c.axisStyle)
+	buf.DrawString(axisX-screen.DisplayWidth(bottom), axisY-1, bounds.Z, bottom, c.axisStyle)
+
+	if n := c.points(); n > 0 {
+		buf.DrawString(plot.X, axisY+1, bounds.Z, "0", c.axisStyle)
+		last := strconv.Itoa(n - 1)
+		if n > 1 && plot.Width > screen.DisplayWidth(last)+1 {
+			buf.DrawString(plot.X+plot.Width-screen.DisplayWidth(last), axisY+1, bounds.Z, last, c.axisStyle)
+		}
+	}
+	return plot
+}
+
+// HandleEvent handles input events
+func (c *Chart) HandleEvent(event input.Event) bool {
+	return false
+}
+
+// Size returns the preferred size
+func (c *Chart) Size() layout.Size {
+	return layout.NewSize(c.width, c.height)
+}
+
+// MinSize returns the minimum size
+func (c *Chart) MinSize() layout.Size {
+	if c.showAxes {
+		return layout.NewSize(4, 3)
+	}
+	return layout.NewSize(1, 1)
+}
@@ -0,0 +1,78 @@
+package widget
+
+import (
+	"math"
+	"testing"
+
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestChartToPixel(t *testing.T) {
+	tests := []struct {
+		i      int
+		v      float64
+		n      int
+		wx, wy int
+	}{
+		{0, 0, 5, 0, 20},
+		{4, 10, 5, 40, 0},
+		{2, 5, 5, 20, 10},
+		{1, 2.5, 5, 10, 15},
+		{0, 7, 1, 0, 6}, // A single point sits at the left
+	}
+	for _, tt := range tests {
+		x, y := toPixel(tt.i, tt.v, tt.n, 41, 21, 0, 10)
+		if x != tt.wx || y != tt.wy {
+			t.Errorf("toPixel(%d, %v, %d) = %d, %d, want %d, %d", tt.i, tt.v, tt.n, x, y, tt.wx, tt.wy)
+		}
+	}
+}
+
+func TestChartYRange(t *testing.T) {
+	style := terminal.DefaultStyle()
+	tests := []struct {
+		name   string
+		series [][]float64
+		lo, hi float64
+	}{
+		{"empty", nil, 0, 1},
+		{"empty series", [][]float64{{}}, 0, 1},
+		{"spans all series", [][]float64{{1, 5, 3}, {-2, math.NaN()}}, -2, 5},
+		{"equal values widened", [][]float64{{3, 3}}, 2, 4},
+		{"infinities skipped", [][]float64{{math.Inf(1), 1, 2, math.Inf(-1)}}, 1, 2},
+	}
+	for _, tt := range tests {
+		c := NewChart()
+		for _, data := range tt.series {
+			c.AddSeries("s", data, style)
+		}
+		if lo, hi := c.YRange(); lo != tt.lo || hi != tt.hi {
+			t.Errorf("%s: YRange() = %v, %v, want %v, %v", tt.name, lo, hi, tt.lo, tt.hi)
+		}
+	}
+
+	c := NewChart().AddSeries("s", []float64{1, 2}, style).SetYRange(-10, 10)
+	if lo, hi := c.YRange(); lo != -10 || hi != 10 {
+		t.Errorf("fixed YRange() = %v, %v, want -10, 10", lo, hi)
+	}
+	if lo, hi := c.AutoYRange().YRange(); lo != 1 || hi != 2 {
+		t.Errorf("YRange() after AutoYRange = %v, %v, want 1, 2", lo, hi)
+	}
+}
+
+func TestChartPlotsSeries(t *testing.T) {
+	c := NewChart().SetShowAxes(false).AddSeries("up", []float64{0, 3}, terminal.DefaultStyle())
+	if got := renderWidget(c, 2, 1).ToString(); got != "⡠⠊" {
+		t.Errorf("rendered %q, want %q", got, "⡠⠊")
+	}
+
+	// Series of differing lengths share the x scale of the longest
+	c.AddSeries("short", []float64{3}, terminal.DefaultStyle())
+	if got := renderWidget(c, 2, 1).ToString(); got != "⡡⠊" {
+		t.Errorf("with a shorter series rendered %q, want %q", got, "⡡⠊")
+	}
+	c.ClearSeries().AddSeries("empty", nil, terminal.DefaultStyle())
+	if got := renderWidget(c, 2, 1).ToString(); got != "" {
+		t.Errorf("empty series rendered %q, want nothing", got)
+	}
+}
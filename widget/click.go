@@ -0,0 +1,33 @@
+package widget
+
+import "github.com/agiles231/gotui/input"
+
+// clickTracker turns double-clicks into row activations, requiring both
+// presses to land on the same row
+// The app decides what counts as a double-click; see MouseEvent.DoubleClick
+type clickTracker struct {
+	index int // Row of the last press
+}
+
+// newClickTracker creates a click tracker with no press recorded
+func newClickTracker() clickTracker {
+	return clickTracker{index: -1}
+}
+
+// press records press e on row index
+// Returns true if it completes a double-click on that row
+func (c *clickTracker) press(e input.MouseEvent, index int) bool {
+	double := e.DoubleClick && c.index == index
+	c.index = index
+	return double
+}
+
+// leftPress returns the mouse event if event is a left button press, rather
+// than a drag, release or another button
+func leftPress(event input.Event) (input.MouseEvent, bool) {
+	e, ok := event.(input.MouseEvent)
+	if !ok || e.Button != input.MouseLeft || e.Motion {
+		return input.MouseEvent{}, false
+	}
+	return e, true
+}
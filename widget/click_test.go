@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// leftClick returns a left press at (x, y), a double-click if double is set
+func leftClick(x, y int, double bool) input.MouseEvent {
+	return input.MouseEvent{X: x, Y: y, Button: input.MouseLeft, DoubleClick: double}
+}
+
+func TestTableRowAt(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     bool
+		border     bool
+		rowBorders bool
+		offset     int
+		x, y       int
+		want       int
+	}{
+		{"plain", false, false, false, 0, 1, 2, 2},
+		{"plain scrolled", false, false, false, 5, 1, 2, 7},
+		{"header", true, false, false, 0, 1, 0, -1},
+		{"below header", true, false, false, 0, 1, 3, 2},
+		{"below header scrolled", true, false, false, 4, 1, 3, 6},
+		{"top border", true, true, false, 0, 1, 0, -1},
+		{"header in border", true, true, false, 0, 1, 1, -1},
+		{"first row in border", true, true, false, 0, 1, 2, 0},
+		{"left border", true, true, false, 0, 0, 2, -1},
+		{"row border", false, true, true, 0, 1, 2, -1},
+		{"after row border", false, true, true, 0, 1, 3, 1},
+		{"after row border scrolled", false, true, true, 2, 1, 5, 4},
+		{"header separator", true, true, true, 0, 1, 2, -1},
+		{"after header separator", true, true, true, 0, 1, 5, 1},
+		{"past the rows", false, false, false, 0, 1, 20, -1},
+	}
+	for _, tt := range tests {
+		table := newTestTable([]TableColumn{{Title: "H", Flex: 1}}, numberedRows(20)).
+			SetShowHeader(tt.header).SetShowBorder(tt.border).SetRowBorders(tt.rowBorders)
+		renderWidget(table, 8, 10)
+		table.scrollBy(tt.offset)
+		if got := table.rowAt(tt.x, tt.y); got != tt.want {
+			t.Errorf("%s: rowAt(%d, %d) = %d, want %d", tt.name, tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestTableClickSelectsAndDoubleClickActivates(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "H", Flex: 1}}, numberedRows(20)).SetShowHeader(true)
+	activated := -1
+	table.OnActivate(func(row int) { activated = row })
+	renderWidget(table, 8, 10)
+
+	if !table.HandleEvent(leftClick(1, 3, false)) || table.SelectedRow() != 2 {
+		t.Fatalf("click selected row %d, want 2", table.SelectedRow())
+	}
+	if activated != -1 {
+		t.Error("single click activated the row")
+	}
+	if table.HandleEvent(leftClick(1, 0, false)) || table.SelectedRow() != 2 {
+		t.Error("click on the header changed the selection")
+	}
+	table.HandleEvent(leftClick(1, 3, false))
+	table.HandleEvent(leftClick(1, 3, true))
+	if activated != 2 {
+		t.Errorf("double-click activated row %d, want 2", activated)
+	}
+}
+
+func TestListClickSelectsAndDoubleClickActivates(t *testing.T) {
+	list := NewList().SetStrings(numberedStrings(20)).SetHeight(8).SetShowBorder(true)
+	activated := -1
+	list.OnActivate(func(index int, item ListItem) { activated = index })
+	renderWidget(list, 8, 10)
+	list.scrollBy(3)
+
+	if !list.HandleEvent(leftClick(1, 2, false)) || list.Cursor() != 4 {
+		t.Fatalf("click moved the cursor to %d, want 4", list.Cursor())
+	}
+	if selected := list.Selected(); len(selected) != 1 || selected[0] != 4 {
+		t.Errorf("Selected() = %v, want [4]", selected)
+	}
+	if list.HandleEvent(leftClick(1, 0, false)) || list.Cursor() != 4 {
+		t.Error("click on the border moved the cursor")
+	}
+	// A double-click on another item only completes on the same item
+	list.HandleEvent(leftClick(1, 5, true))
+	if activated != -1 {
+		t.Errorf("double-click on a new item activated %d", activated)
+	}
+	list.HandleEvent(leftClick(1, 5, true))
+	if activated != 7 {
+		t.Errorf("double-click activated %d, want 7", activated)
+	}
+}
@@ -0,0 +1,41 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+)
+
+// EventContext gives widgets access to the app running them while they
+// handle events, without capturing the app in closures
+type EventContext interface {
+	// RequestRender asks for the screen to be redrawn
+	RequestRender()
+	// PushOverlay shows w as a modal overlay
+	PushOverlay(w Widget)
+	// PopOverlay removes and returns the top overlay, or nil if there is none
+	PopOverlay() Widget
+	// Size returns the size of the screen
+	Size() layout.Size
+	// Confirm shows a modal yes/no question, passing the answer to onDone
+	Confirm(message string, onDone func(ok bool))
+}
+
+// ContextHandler is implemented by widgets that handle events with access
+// to the app
+type ContextHandler interface {
+	// HandleEventCtx processes an input event like HandleEvent
+	// Returns true if the event was handled
+	HandleEventCtx(ctx EventContext, event input.Event) bool
+}
+
+// HandleEventCtx delivers event to w through HandleEventCtx if w is a
+// ContextHandler and ctx is set, or HandleEvent otherwise
+// Containers passing events on to their children use it to pass ctx along.
+// Their HandleEvent can call their HandleEventCtx with a nil ctx, so both
+// share one implementation.
+func HandleEventCtx(w Widget, ctx EventContext, event input.Event) bool {
+	if h, ok := w.(ContextHandler); ok && ctx != nil {
+		return h.HandleEventCtx(ctx, event)
+	}
+	return w.HandleEvent(event)
+}
@@ -0,0 +1,46 @@
+package widget
+
+import "time"
+
+// debouncer delays change notifications until input has been quiet for a
+// given duration; with no delay every change is reported immediately
+type debouncer struct {
+	delay      time.Duration
+	pending    bool
+	lastChange time.Time
+	now        func() time.Time
+}
+
+// newDebouncer creates a debouncer with no delay
+func newDebouncer() debouncer {
+	return debouncer{now: time.Now}
+}
+
+// change records a change
+// Returns true if it should be reported immediately
+func (d *debouncer) change() bool {
+	if d.delay <= 0 {
+		return true
+	}
+	d.pending = true
+	d.lastChange = d.now()
+	return false
+}
+
+// ready reports whether a pending change has been quiet for the delay at
+// now, clearing it if so
+func (d *debouncer) ready(now time.Time) bool {
+	if !d.pending || now.Sub(d.lastChange) < d.delay {
+		return false
+	}
+	d.pending = false
+	return true
+}
+
+// flush clears a pending change
+// Returns true if there was one
+func (d *debouncer) flush() bool {
+	pending := d.pending
+	d.pending = false
+	return pending
+}
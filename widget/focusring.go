@@ -0,0 +1,44 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// DrawFocusRing marks bounds as focused with corner glyphs on the cells
+// diagonally outside its corners, leaving the widget's own cells alone
+// Corners that fall off the buffer are skipped.
+func DrawFocusRing(buf *screen.Buffer, bounds layout.Rect, style terminal.Style) {
+	if bounds.IsEmpty() {
+		return
+	}
+	borders := screen.DefaultBorders()
+	left, top := bounds.X-1, bounds.Y-1
+	right, bottom := bounds.X+bounds.Width, bounds.Y+bounds.Height
+	buf.Set(left, top, bounds.Z, screen.NewCell(borders.TopLeft, style))
+	buf.Set(right, top, bounds.Z, screen.NewCell(borders.TopRight, style))
+	buf.Set(left, bottom, bounds.Z, screen.NewCell(borders.BottomLeft, style))
+	buf.Set(right, bottom, bounds.Z, screen.NewCell(borders.BottomRight, style))
+}
+
+// FocusedWidget returns the innermost focused widget within root that was
+// rendered, or nil if there is none
+// Focus is followed through Children from root, so containers count as
+// focused only when none of their children are.
+func FocusedWidget(root Widget) Widget {
+	if root == nil || !root.IsFocused() || !isVisible(root) {
+		return nil
+	}
+	if parent, ok := root.(interface{ Children() []Widget }); ok {
+		for _, child := range parent.Children() {
+			if focused := FocusedWidget(child); focused != nil {
+				return focused
+			}
+		}
+	}
+	if _, ok := renderedBounds(root); !ok {
+		return nil
+	}
+	return root
+}
@@ -0,0 +1,44 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestDrawFocusRing(t *testing.T) {
+	buf := screen.NewBuffer(5, 4, screen.DefaultDepth)
+	DrawFocusRing(buf, layout.NewRect(1, 1, 0, 3, 2), terminal.DefaultStyle())
+	want := "┌   ┐\n\n\n└   ┘"
+	if got := buf.ToString(); got != want {
+		t.Errorf("ring drawn as\n%q\nwant\n%q", got, want)
+	}
+
+	buf = screen.NewBuffer(5, 4, screen.DefaultDepth)
+	DrawFocusRing(buf, layout.NewRect(0, 0, 0, 5, 3), terminal.DefaultStyle())
+	if got := buf.ToString(); got != "\n\n\n" {
+		t.Errorf("ring around the whole buffer drawn as %q, want only off-buffer corners", got)
+	}
+}
+
+func TestFocusedWidget(t *testing.T) {
+	f := NewForm()
+	first := f.AddTextInput("First", "")
+	second := f.AddTextInput("Second", "")
+	f.SetFocused(true)
+	if got := FocusedWidget(f); got != nil {
+		t.Errorf("FocusedWidget() before rendering = %v, want nil", got)
+	}
+
+	renderWidget(f, 30, 8)
+	if got := FocusedWidget(f); got != first {
+		t.Errorf("FocusedWidget() = %v, want the first field", got)
+	}
+	f.HandleEvent(input.KeyEvent{Key: input.KeyTab})
+	if got := FocusedWidget(f); got != second {
+		t.Errorf("FocusedWidget() after Tab = %v, want the second field", got)
+	}
+}
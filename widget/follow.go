@@ -0,0 +1,43 @@
+package widget
+
+// followScroll is a vertical scroll offset that can follow the end of
+// growing content, like tail -f
+// Scrolling up pauses following and scrolling back to the bottom resumes it
+type followScroll struct {
+	offset  int
+	enabled bool // Follow mode is on
+	paused  bool // The user scrolled away from the bottom
+}
+
+// following returns whether the view is pinned to the bottom
+func (f *followScroll) following() bool {
+	return f.enabled && !f.paused
+}
+
+// setEnabled turns follow mode on or off, resuming following if on
+func (f *followScroll) setEnabled(enabled bool) {
+	f.enabled = enabled
+	f.paused = false
+}
+
+// scrollBy scrolls by lines over total lines with visible lines shown,
+// pausing following when scrolling up and resuming it at the bottom
+func (f *followScroll) scrollBy(lines, total, visible int) {
+	f.offset = clampOffset(f.offset+lines, total, visible)
+	f.paused = f.offset < total-visible
+}
+
+// scrollTo scrolls so line offset is at the top, like scrollBy
+func (f *followScroll) scrollTo(offset, total, visible int) {
+	f.scrollBy(offset-f.offset, total, visible)
+}
+
+// update keeps the offset valid after the content or view changed size,
+// moving to the bottom while following
+func (f *followScroll) update(total, visible int) {
+	if f.following() {
+		f.offset = max(0, total-visible)
+		return
+	}
+	f.offset = clampOffset(f.offset, total, visible)
+}
@@ -1,31 +1,63 @@
 package widget
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
 	"github.com/agiles231/gotui/terminal"
 )
 
+// FormLayout controls how a Form arranges its fields
+type FormLayout int
+
+const (
+	// FormLayoutVertical puts each field on its own row
+	FormLayoutVertical FormLayout = iota
+	// FormLayoutHorizontal packs fields side by side, wrapping to a new row
+	// when the width runs out
+	FormLayoutHorizontal
+)
+
+// formFieldGap is the space between fields sharing a row
+const formFieldGap = 2
+
 // FormField represents a field in a form
 type FormField struct {
-	Label  string
-	Widget Widget
+	Label    string
+	Widget   Widget
+	TabIndex int  // Tab visits fields in ascending TabIndex, then insertion order
+	Required bool // Submit is blocked while the field is empty
+
+	labelStyle    terminal.Style
+	hasLabelStyle bool
 }
 
 // Form is a container for form fields
 type Form struct {
 	BaseWidget
+	background    background
 	fields        []FormField
 	buttons       []*Button
 	focusedField  int
 	focusedButton int // -1 means no button focused
 	labelWidth    int
+	layout        FormLayout
+	offset        int // Scroll offset in rows
+	showScrollbar bool
+	scrollbar     *Scrollbar
 	style         terminal.Style
 	labelStyle    terminal.Style
+	requiredStyle terminal.Style
 	showBorder    bool
 	title         string
 	onSubmit      func(values map[string]string)
+	onInvalid     func(missing []string)
+	defaults      map[string]string
+	dirty         bool
+	onDirtyChange func(dirty bool)
 }
 
 // NewForm creates a new form widget
@@ -35,7 +67,9 @@ func NewForm() *Form {
 		labelWidth:    15,
 		style:         terminal.DefaultStyle(),
 		labelStyle:    terminal.DefaultStyle().WithBold(),
+		requiredStyle: terminal.DefaultStyle().WithFG(terminal.ColorRed).WithBold(),
 		focusedButton: -1, // No button focused initially
+		scrollbar:     NewScrollbar(layout.Vertical),
 	}
 	f.SetInteractive(true)
 	return f
@@ -57,6 +91,32 @@ func (f *Form) AddField(label string, widget Widget) *Form {
 	return f
 }
 
+// AddFieldWithTabIndex adds a field visited by Tab in order of tabIndex
+// rather than insertion order
+func (f *Form) AddFieldWithTabIndex(label string, widget Widget, tabIndex int) *Form {
+	f.AddField(label, widget)
+	f.fields[len(f.fields)-1].TabIndex = tabIndex
+	return f
+}
+
+// isLastField returns true if field is the last one in Tab order
+func (f *Form) isLastField(field int) bool {
+	order := f.tabOrder()
+	return len(order) > 0 && order[len(order)-1] == field
+}
+
+// tabOrder returns the field indexes in Tab order
+func (f *Form) tabOrder() []int {
+	order := make([]int, len(f.fields))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return f.fields[a].TabIndex - f.fields[b].TabIndex
+	})
+	return order
+}
+
 // AddTextInput adds a text input field
 func (f *Form) AddTextInput(label, placeholder string) *TextInput {
 	ti := NewTextInput().SetPlaceholder(placeholder)
@@ -71,6 +131,14 @@ func (f *Form) AddPasswordInput(label, placeholder string) *TextInput {
 	return ti
 }
 
+// AddRequiredTextInput adds a text input field that must be filled in
+// before the form can be submitted
+func (f *Form) AddRequiredTextInput(label, placeholder string) *TextInput {
+	ti := f.AddTextInput(label, placeholder)
+	f.fields[len(f.fields)-1].Required = true
+	return ti
+}
+
 // AddButton adds a button to the form's button row
 func (f *Form) AddButton(label string, onPress func()) *Button {
 	btn := NewButton(label).OnPress(onPress)
@@ -78,17 +146,81 @@ func (f *Form) AddButton(label string, onPress func()) *Button {
 	return btn
 }
 
+// AddSubmitButton adds a button to the form's button row that submits
+// the form
+func (f *Form) AddSubmitButton(label string) *Button {
+	return f.AddButton(label, func() { f.Submit() })
+}
+
 // Fields returns the form fields
 func (f *Form) Fields() []FormField {
 	return f.fields
 }
 
+// Children returns the field widgets followed by the buttons
+func (f *Form) Children() []Widget {
+	children := make([]Widget, 0, len(f.fields)+len(f.buttons))
+	for _, field := range f.fields {
+		children = append(children, field.Widget)
+	}
+	for _, btn := range f.buttons {
+		children = append(children, btn)
+	}
+	return children
+}
+
+// SetVisibleRecursive sets the visibility of the form and all its children
+func (f *Form) SetVisibleRecursive(visible bool) {
+	setVisibleRecursive(f, visible)
+}
+
+// RequiredFields returns the labels of the required fields
+func (f *Form) RequiredFields() []string {
+	var labels []string
+	for _, field := range f.fields {
+		if field.Required {
+			labels = append(labels, field.Label)
+		}
+	}
+	return labels
+}
+
+// SetFieldLabelStyle overrides the label style of the field with the
+// given label
+func (f *Form) SetFieldLabelStyle(label string, style terminal.Style) *Form {
+	for i := range f.fields {
+		if f.fields[i].Label == label {
+			f.fields[i].labelStyle = style
+			f.fields[i].hasLabelStyle = true
+		}
+	}
+	return f
+}
+
 // SetLabelWidth sets the label column width
 func (f *Form) SetLabelWidth(width int) *Form {
 	f.labelWidth = width
 	return f
 }
 
+// SetLayout sets how fields are arranged
+func (f *Form) SetLayout(layout FormLayout) *Form {
+	f.layout = layout
+	return f
+}
+
+// Layout returns how fields are arranged
+func (f *Form) Layout() FormLayout {
+	return f.layout
+}
+
+// SetShowScrollbar enables or disables the scrollbar shown when the
+// fields don't fit
+func (f *Form) SetShowScrollbar(show bool) *Form {
+	f.showScrollbar = show
+	return f
+}
+
 // SetStyle sets the form style
 func (f *Form) SetStyle(style terminal.Style) *Form {
 	f.style = style
@@ -113,13 +245,113 @@ func (f *Form) SetTitle(title string) *Form {
 	return f
 }
 
+// SetRequiredStyle sets the style of the * marking required fields
+func (f *Form) SetRequiredStyle(style terminal.Style) *Form {
+	f.requiredStyle = style
+	return f
+}
+
 // OnSubmit sets the submit callback
+// It is called through Submit, which a button added with AddSubmitButton
+// or Enter in the last field in Tab order calls.
 func (f *Form) OnSubmit(fn func(values map[string]string)) *Form {
 	f.onSubmit = fn
 	return f
 }
 
+// OnInvalid sets the callback for a submit blocked by empty required fields
+func (f *Form) OnInvalid(fn func(missing []string)) *Form {
+	f.onInvalid = fn
+	return f
+}
+
+// Validate returns the labels of required fields that are empty
+func (f *Form) Validate() []string {
+	values := f.Values()
+	var missing []string
+	for _, field := range f.fields {
+		if field.Required && strings.Join(values[field.Label], "") == "" {
+			missing = append(missing, field.Label)
+		}
+	}
+	return missing
+}
+
+// Submit reports the values to the submit callback, unless a required
+// field is empty, in which case the invalid callback gets the missing labels
+// Multiple values of one field are joined with ", "
+// Returns true if the form was submitted
+func (f *Form) Submit() bool {
+	if missing := f.Validate(); len(missing) > 0 {
+		if f.onInvalid != nil {
+			f.onInvalid(missing)
+		}
+		return false
+	}
+	if f.onSubmit != nil {
+		values := make(map[string]string)
+		for label, v := range f.Values() {
+			values[label] = strings.Join(v, ", ")
+		}
+		f.onSubmit(values)
+	}
+	return true
+}
+
+// SetDefaults sets the default value of fields by label and resets the form
+// to them; fields without a default reset to empty
+func (f *Form) SetDefaults(defaults map[string]string) *Form {
+	f.defaults = make(map[string]string, len(defaults))
+	for label, value := range defaults {
+		f.defaults[label] = value
+	}
+	f.Reset()
+	return f
+}
+
+// Reset restores every field that is a ValueSetter to its default
+func (f *Form) Reset() *Form {
+	for _, field := range f.fields {
+		if setter, ok := field.Widget.(ValueSetter); ok {
+			setter.SetFieldValue(f.defaults[field.Label])
+		}
+	}
+	f.updateDirty()
+	return f
+}
+
+// IsDirty returns whether any Valued field differs from its default
+func (f *Form) IsDirty() bool {
+	for _, field := range f.fields {
+		if v, ok := field.Widget.(Valued); ok && v.FieldValue() != f.defaults[field.Label] {
+			return true
+		}
+	}
+	return false
+}
+
+// OnDirtyChange sets the callback for the form becoming dirty or clean
+// The state is checked after the form handles an event and on Reset
+func (f *Form) OnDirtyChange(fn func(dirty bool)) *Form {
+	f.onDirtyChange = fn
+	return f
+}
+
+// updateDirty fires the dirty change callback if the dirty state changed
+func (f *Form) updateDirty() {
+	dirty := f.IsDirty()
+	if dirty == f.dirty {
+		return
+	}
+	f.dirty = dirty
+	if f.onDirtyChange != nil {
+		f.onDirtyChange(dirty)
+	}
+}
+
 // Values returns a map of field labels to values
+// Fields whose widget isn't Valued are left out; a List gives one value per
+// selected item
 func (f *Form) Values() map[string][]string {
 	values := make(map[string][]string)
 	for _, field := range f.fields {
@@ -132,84 +364,212 @@ func (f *Form) Values() map[string][]string {
 				valueSlice[i] = val.Text
 			}
 			values[field.Label] = valueSlice
+		} else if v, ok := field.Widget.(Valued); ok {
+			values[field.Label] = []string{v.FieldValue()}
 		}
 	}
 	return values
 }
 
+// SetBackground fills the whole bounds with spaces in style before drawing
+// the content; by default only the rows with content are drawn
+func (f *Form) SetBackground(style terminal.Style) *Form {
+	f.background.set(style)
+	return f
+}
+
 // Render draws the form
 func (f *Form) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !f.visible {
+	f.bounds = bounds
+	if !f.visible || bounds.IsEmpty() {
 		return
 	}
+	f.background.fill(buf, bounds)
 
 	innerBounds := bounds
-	y := bounds.Y
+	if f.showBorder {
+		innerBounds = bounds.Inset(1, 1, 1, 1)
+	}
+
+	// The fields scroll between the title and the buttons row
+	fieldsTop := innerBounds.Y
+	if f.title != "" {
+		fieldsTop++
+	}
+	viewRows := innerBounds.Y + innerBounds.Height - fieldsTop
+	if len(f.buttons) > 0 {
+		viewRows -= 2
+	}
+	viewRows = max(0, viewRows)
+	fieldsWidth := innerBounds.Width
+	rects, rows := f.placeFields(innerBounds.X, fieldsTop, innerBounds.Z, fieldsWidth)
+	overflow := rows > viewRows
+	if overflow && f.showScrollbar {
+		fieldsWidth--
+		rects, rows = f.placeFields(innerBounds.X, fieldsTop, innerBounds.Z, fieldsWidth)
+	}
+	f.ensureVisible(rects, fieldsTop, rows, viewRows)
+	shownRows := min(rows, viewRows)
+	y := innerBounds.Y
 
 	if f.showBorder {
-		height := len(f.fields) + 2
+		height := shownRows + 2
 		if f.title != "" {
 			height++
 		}
 		if len(f.buttons) > 0 {
 			height += 2 // Extra row for buttons + spacing
 		}
-		buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, height, f.style)
-		innerBounds = bounds.Inset(1, 1, 1, 1)
-		y = innerBounds.Y
+		buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, min(height, bounds.Height), f.style)
 	}
 
 	// Draw title
-	if f.title != "" {
-		titleX := innerBounds.X + (innerBounds.Width-len(f.title))/2
-		buf.DrawString(titleX, y, innerBounds.Z, f.title, f.labelStyle)
+	if f.title != "" && !innerBounds.IsEmpty() {
+		title := screen.Truncate(f.title, innerBounds.Width, false)
+		titleX := innerBounds.X + (innerBounds.Width-screen.DisplayWidth(title))/2
+		buf.DrawString(titleX, y, innerBounds.Z, title, f.labelStyle)
 		y++
 	}
 
 	// Draw fields
 	for i, field := range f.fields {
-		// Draw label
-		label := field.Label
-		if len(label) > f.labelWidth-2 {
-			label = label[:f.labelWidth-2]
+		rect := rects[i]
+		rect.Y -= f.offset
+		if rect.Y < fieldsTop || rect.Y >= fieldsTop+viewRows {
+			continue
 		}
-		label += ": "
-		buf.DrawString(innerBounds.X, y+i, innerBounds.Z, label, f.labelStyle)
+
+		// Draw label, with a * after it for required fields
+		labelStyle := f.labelStyle
+		if field.hasLabelStyle {
+			labelStyle = field.labelStyle
+		}
+		labelRoom := f.labelWidth - 2
+		if field.Required {
+			labelRoom--
+		}
+		right := innerBounds.Right()
+		label := screen.Truncate(field.Label, labelRoom, false)
+		buf.DrawStringClipped(rect.X, rect.Y, rect.Z, label, labelStyle, right-rect.X)
+		labelEnd := rect.X + screen.DisplayWidth(label)
+		if field.Required && labelEnd < right {
+			buf.Set(labelEnd, rect.Y, rect.Z, screen.NewCell('*', f.requiredStyle))
+			labelEnd++
+		}
+		buf.DrawStringClipped(labelEnd, rect.Y, rect.Z, ": ", labelStyle, right-labelEnd)
 
 		// Calculate widget bounds
 		widgetBounds := layout.NewRect(
-			innerBounds.X+f.labelWidth,
-			y+i,
-			innerBounds.Z,
-			innerBounds.Width-f.labelWidth,
+			rect.X+f.labelWidth,
+			rect.Y,
+			rect.Z,
+			rect.Width-f.labelWidth,
 			1,
-		)
+		).Intersection(innerBounds)
 
 		// Render widget
-		field.Widget.Render(buf, widgetBounds)
+		field.Widget.Render(buf.Clip(widgetBounds), widgetBounds)
+	}
+
+	if overflow && f.showScrollbar {
+		scrollBounds := layout.NewRect(innerBounds.X+innerBounds.Width-1, fieldsTop, innerBounds.Z, 1, viewRows)
+		f.scrollbar.Render(buf, scrollBounds, rows, viewRows, f.offset)
 	}
 
 	// Draw buttons row
 	if len(f.buttons) > 0 {
-		buttonY := y + len(f.fields) + 1 // Leave a blank line
+		buttonY := fieldsTop + shownRows + 1 // Leave a blank line
 		buttonX := innerBounds.X
 		
 		for i, btn := range f.buttons {
 			btnWidth := btn.Size().Width
-			btnBounds := layout.NewRect(buttonX, buttonY, innerBounds.Z, btnWidth, 1)
-			
+			btnBounds := layout.NewRect(buttonX, buttonY, innerBounds.Z, btnWidth, 1).Intersection(innerBounds)
+
 			// Set button focus state
 			btn.SetFocused(f.focusedButton == i)
-			btn.Render(buf, btnBounds)
+			btn.Render(buf.Clip(btnBounds), btnBounds)
 			
 			buttonX += btnWidth + 2 // 2 spaces between buttons
 		}
 	}
 }
 
+// ensureVisible scrolls so the focused field is within the viewRows rows
+// below top, given the unscrolled field bounds
+func (f *Form) ensureVisible(rects []layout.Rect, top, rows, viewRows int) {
+	if f.focusedField >= 0 && f.focusedField < len(rects) {
+		row := rects[f.focusedField].Y - top
+		if row < f.offset {
+			f.offset = row
+		}
+		if row >= f.offset+viewRows {
+			f.offset = row - viewRows + 1
+		}
+	}
+	f.offset = clampOffset(f.offset, rows, viewRows)
+}
+
+// fieldWidth returns the width of a field's label and widget together
+func (f *Form) fieldWidth(field FormField) int {
+	return f.labelWidth + max(1, field.Widget.Size().Width)
+}
+
+// fitsHorizontal reports whether every field fits within width, which the
+// horizontal layout needs to avoid clipping fields
+func (f *Form) fitsHorizontal(width int) bool {
+	for _, field := range f.fields {
+		if f.fieldWidth(field) > width {
+			return false
+		}
+	}
+	return true
+}
+
+// placeFields returns the bounds of each field's label and widget when laid
+// out from (x, y) within width, and the number of rows they take
+// The horizontal layout falls back to vertical when a field doesn't fit
+func (f *Form) placeFields(x, y, z, width int) ([]layout.Rect, int) {
+	rects := make([]layout.Rect, len(f.fields))
+	if f.layout != FormLayoutHorizontal || !f.fitsHorizontal(width) {
+		for i := range f.fields {
+			rects[i] = layout.NewRect(x, y+i, z, width, 1)
+		}
+		return rects, len(f.fields)
+	}
+
+	row, col := 0, 0
+	for i, field := range f.fields {
+		w := f.fieldWidth(field)
+		if col > 0 && col+w > width {
+			row++
+			col = 0
+		}
+		rects[i] = layout.NewRect(x+col, y+row, z, w, 1)
+		col += w + formFieldGap
+	}
+	if len(f.fields) == 0 {
+		return rects, 0
+	}
+	return rects, row + 1
+}
+
 // HandleEvent handles input events
 func (f *Form) HandleEvent(event input.Event) bool {
-	if !f.focused {
+	return f.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx handles input events, passing ctx to the fields and
+// buttons
+func (f *Form) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	handled := f.handleEvent(ctx, event)
+	if handled {
+		f.updateDirty()
+	}
+	return handled
+}
+
+func (f *Form) handleEvent(ctx EventContext, event input.Event) bool {
+	if !f.visible || !f.focused {
 		return false
 	}
 	
@@ -233,6 +593,11 @@ func (f *Form) HandleEvent(event input.Event) bool {
 		return true
 	}
 
+	// Alt+hotkey presses a button even while a field has focus
+	if keyEvent.IsAlt() && f.pressHotkey(keyEvent) {
+		return true
+	}
+
 	// If on button row, use Left/Right for button navigation
 	if f.focusedButton >= 0 {
 		if keyEvent.Key == input.KeyLeft {
@@ -251,12 +616,13 @@ func (f *Form) HandleEvent(event input.Event) bool {
 		// Enter/Space triggers button
 		if keyEvent.Key == input.KeyEnter || (keyEvent.Key == input.KeyRune && keyEvent.Rune == ' ') {
 			btn := f.buttons[f.focusedButton]
-			return btn.HandleEvent(event)
+			return HandleEventCtx(btn, ctx, event)
 		}
 		// Consume Down arrow on buttons (nowhere to go)
 		if keyEvent.Key == input.KeyDown {
 			return true
 		}
+		return f.pressHotkey(keyEvent)
 	}
 
 	// If on a field, handle Up/Down for field navigation
@@ -280,17 +646,37 @@ func (f *Form) HandleEvent(event input.Event) bool {
 		if keyEvent.Key == input.KeyLeft || keyEvent.Key == input.KeyRight {
 			// Pass to field widget for cursor movement
 			if f.focusedField >= 0 && f.focusedField < len(f.fields) {
-				f.fields[f.focusedField].Widget.HandleEvent(event)
+				HandleEventCtx(f.fields[f.focusedField].Widget, ctx, event)
 			}
 			return true // Always consume to prevent tab switching
 		}
 	}
 
-	// Pass other events to focused field
+	// Pass other events to focused field, then try button hotkeys
+	// Enter in the last field submits once the field has seen it.
 	if f.focusedButton < 0 && f.focusedField >= 0 && f.focusedField < len(f.fields) {
-		return f.fields[f.focusedField].Widget.HandleEvent(event)
+		handled := HandleEventCtx(f.fields[f.focusedField].Widget, ctx, event)
+		if keyEvent.Key == input.KeyEnter && f.isLastField(f.focusedField) {
+			f.Submit()
+			return true
+		}
+		if handled {
+			return true
+		}
 	}
 
+	return f.pressHotkey(keyEvent)
+}
+
+// pressHotkey presses the first button whose hotkey matches e
+// Returns true if a button was pressed
+func (f *Form) pressHotkey(e input.KeyEvent) bool {
+	for _, btn := range f.buttons {
+		if btn.MatchesHotkey(e) {
+			btn.press()
+			return true
+		}
+	}
 	return false
 }
 
@@ -397,6 +783,8 @@ func (f *Form) focusNext() {
 		f.fields[f.focusedField].Widget.SetFocused(false)
 	}
 
+	order := f.tabOrder()
+
 	// Currently on a button?
 	if f.focusedButton >= 0 {
 		// Move to next button or wrap to first field
@@ -406,7 +794,8 @@ func (f *Form) focusNext() {
 			f.focusedButton = -1
 			f.focusedField = 0
 			if totalFields > 0 {
-				f.fields[0].Widget.SetFocused(true)
+				f.focusedField = order[0]
+				f.fields[f.focusedField].Widget.SetFocused(true)
 			}
 			return
 		}
@@ -415,8 +804,8 @@ func (f *Form) focusNext() {
 	}
 
 	// Currently on a field - move to next field or first button
-	f.focusedField++
-	if f.focusedField >= totalFields {
+	pos := slices.Index(order, f.focusedField) + 1
+	if pos >= totalFields {
 		// Move to buttons if any, otherwise wrap to first field
 		if totalButtons > 0 {
 			f.focusedField = -1
@@ -424,9 +813,10 @@ func (f *Form) focusNext() {
 			f.buttons[0].SetFocused(true)
 			return
 		}
-		f.focusedField = 0
+		pos = 0
 	}
-	
+	f.focusedField = order[pos]
+
 	if f.focusedField >= 0 && f.focusedField < totalFields {
 		f.fields[f.focusedField].Widget.SetFocused(true)
 	}
@@ -447,6 +837,8 @@ func (f *Form) focusPrev() {
 		f.fields[f.focusedField].Widget.SetFocused(false)
 	}
 
+	order := f.tabOrder()
+
 	// Currently on a button?
 	if f.focusedButton >= 0 {
 		// Move to previous button or wrap to last field
@@ -454,7 +846,7 @@ func (f *Form) focusPrev() {
 		if f.focusedButton < 0 {
 			// Wrap to last field
 			if totalFields > 0 {
-				f.focusedField = totalFields - 1
+				f.focusedField = order[totalFields-1]
 				f.fields[f.focusedField].Widget.SetFocused(true)
 			} else {
 				// No fields, wrap to last button
@@ -468,17 +860,19 @@ func (f *Form) focusPrev() {
 	}
 
 	// Currently on a field - move to previous field or last button
-	f.focusedField--
-	if f.focusedField < 0 {
+	pos := slices.Index(order, f.focusedField) - 1
+	if pos < 0 {
 		// Move to last button if any, otherwise wrap to last field
 		if totalButtons > 0 {
+			f.focusedField = -1
 			f.focusedButton = totalButtons - 1
 			f.buttons[f.focusedButton].SetFocused(true)
 			return
 		}
-		f.focusedField = totalFields - 1
+		pos = totalFields - 1
 	}
-	
+	f.focusedField = order[pos]
+
 	if f.focusedField >= 0 && f.focusedField < totalFields {
 		f.fields[f.focusedField].Widget.SetFocused(true)
 	}
@@ -486,8 +880,8 @@ func (f *Form) focusPrev() {
 
 // SetFocused sets focus state
 func (f *Form) SetFocused(focused bool) {
-	f.focused = focused
-	
+	f.BaseWidget.SetFocused(focused)
+
 	// Focus/unfocus the current field or button
 	if f.focusedButton >= 0 && f.focusedButton < len(f.buttons) {
 		f.buttons[f.focusedButton].SetFocused(focused)
@@ -500,6 +894,14 @@ func (f *Form) SetFocused(focused bool) {
 func (f *Form) Size() layout.Size {
 	height := len(f.fields)
 	width := f.labelWidth + 20 // Default input width
+	if f.layout == FormLayoutHorizontal && len(f.fields) > 0 {
+		// All fields on one row
+		height = 1
+		width = (len(f.fields) - 1) * formFieldGap
+		for _, field := range f.fields {
+			width += f.fieldWidth(field)
+		}
+	}
 
 	if f.title != "" {
 		height++
@@ -516,7 +918,26 @@ func (f *Form) Size() layout.Size {
 }
 
 // MinSize returns the minimum size
+// This is the same for both layouts, since horizontal falls back to
+// vertical when narrow
 func (f *Form) MinSize() layout.Size {
 	return layout.NewSize(f.labelWidth+10, len(f.fields)+2)
 }
 
+// childLabel returns the label of the field holding child
+func (f *Form) childLabel(child Widget) string {
+	for _, field := range f.fields {
+		if field.Widget == child {
+			return field.Label
+		}
+	}
+	return ""
+}
+
+// AccessibleDescription describes the form by its title
+func (f *Form) AccessibleDescription() string {
+	if f.title == "" {
+		return "Form"
+	}
+	return "Form " + quote(f.title)
+}
@@ -0,0 +1,293 @@
+package widget
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// focusedLabel returns the label of the form's focused field or button
+func focusedLabel(f *Form) string {
+	for _, field := range f.fields {
+		if field.Widget.IsFocused() {
+			return field.Label
+		}
+	}
+	for _, btn := range f.buttons {
+		if btn.IsFocused() {
+			return btn.label
+		}
+	}
+	return ""
+}
+
+func TestFormTabOrder(t *testing.T) {
+	f := NewForm().
+		AddFieldWithTabIndex("A", NewTextInput(), 2).
+		AddFieldWithTabIndex("B", NewTextInput(), 0).
+		AddFieldWithTabIndex("C", NewTextInput(), 1).
+		AddField("D", NewTextInput()) // Index 0, after B
+	f.AddButton("OK", nil)
+	f.SetFocused(true)
+
+	tab := input.KeyEvent{Key: input.KeyTab}
+	for _, want := range []string{"OK", "B", "D", "C", "A"} {
+		f.HandleEvent(tab)
+		if got := focusedLabel(f); got != want {
+			t.Fatalf("Tab focused %q, want %q", got, want)
+		}
+	}
+	backTab := input.KeyEvent{Key: input.KeyTab, Modifier: input.ModShift}
+	for _, want := range []string{"C", "D", "B", "OK", "A"} {
+		f.HandleEvent(backTab)
+		if got := focusedLabel(f); got != want {
+			t.Fatalf("Shift+Tab focused %q, want %q", got, want)
+		}
+	}
+}
+
+func TestFormEnterSubmitsFromLastFieldInTabOrder(t *testing.T) {
+	first, last := NewTextInput(), NewTextInput()
+	f := NewForm().AddFieldWithTabIndex("First", first, 1).AddFieldWithTabIndex("Last", last, 0)
+	submitted := 0
+	f.OnSubmit(func(map[string]string) { submitted++ })
+	f.SetFocused(true)
+
+	f.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if submitted != 1 {
+		t.Errorf("Enter in the field last in Tab order submitted %d times, want 1", submitted)
+	}
+	f.HandleEvent(input.KeyEvent{Key: input.KeyTab})
+	f.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if submitted != 1 {
+		t.Error("Enter in a field before the last in Tab order submitted")
+	}
+}
+
+func TestFormEnterReportsMissingRequiredFields(t *testing.T) {
+	f := NewForm()
+	f.AddTextInput("Name", "Ada")
+	f.AddRequiredTextInput("Email", "")
+	var missing []string
+	f.OnInvalid(func(labels []string) { missing = labels })
+	f.SetFocused(true)
+	f.HandleEvent(input.KeyEvent{Key: input.KeyTab})
+
+	// No OnSubmit is set, but Enter still validates
+	if !f.HandleEvent(input.KeyEvent{Key: input.KeyEnter}) {
+		t.Error("Enter in the last field was not handled")
+	}
+	if len(missing) != 1 || missing[0] != "Email" {
+		t.Errorf("OnInvalid got %v, want [Email]", missing)
+	}
+}
+
+func TestFormRequiredFields(t *testing.T) {
+	f := NewForm().SetLabelWidth(8)
+	name := f.AddRequiredTextInput("Name", "")
+	f.AddTextInput("Note", "")
+	var submitted map[string]string
+	var missing []string
+	f.OnSubmit(func(values map[string]string) { submitted = values })
+	f.OnInvalid(func(labels []string) { missing = labels })
+
+	if got := f.RequiredFields(); len(got) != 1 || got[0] != "Name" {
+		t.Errorf("RequiredFields() = %v, want [Name]", got)
+	}
+	buf := renderWidget(f, 20, 2)
+	if got := buf.ToString(); got != "Name*:\nNote:" {
+		t.Errorf("rendered %q, want a * after the required label", got)
+	}
+	if cell := buf.Get(4, 0, 0); cell.Style != f.requiredStyle {
+		t.Errorf("* drawn in %+v, want the required style", cell.Style)
+	}
+
+	if f.Submit() || submitted != nil {
+		t.Error("Submit succeeded with an empty required field")
+	}
+	if len(missing) != 1 || missing[0] != "Name" {
+		t.Errorf("OnInvalid got %v, want [Name]", missing)
+	}
+
+	name.SetValue("Ada")
+	if !f.Submit() || submitted["Name"] != "Ada" {
+		t.Errorf("Submit with the required field filled gave %v", submitted)
+	}
+}
+
+func TestFormFieldLabelStyle(t *testing.T) {
+	f := NewForm().SetLabelWidth(8)
+	f.AddTextInput("Name", "")
+	f.AddTextInput("Note", "")
+	accent := f.labelStyle.WithFG(terminal.ColorGreen)
+	f.SetFieldLabelStyle("Note", accent)
+
+	buf := renderWidget(f, 20, 2)
+	if got := buf.Get(0, 0, 0).Style; got != f.labelStyle {
+		t.Errorf("Name label drawn in %+v, want the form's label style", got)
+	}
+	if got := buf.Get(0, 1, 0).Style; got != accent {
+		t.Errorf("Note label drawn in %+v, want its own style", got)
+	}
+}
+
+func TestFormHorizontalLayout(t *testing.T) {
+	f := NewForm().SetLabelWidth(6).SetLayout(FormLayoutHorizontal)
+	inputs := []*TextInput{NewTextInput().SetWidth(4), NewTextInput().SetWidth(4), NewTextInput().SetWidth(4)}
+	for i, ti := range inputs {
+		f.AddField(string(rune('A'+i)), ti)
+	}
+	if got := f.Size(); got != layout.NewSize(34, 1) {
+		t.Errorf("Size() = %v, want all fields on one row", got)
+	}
+
+	// Two 10-wide fields and a gap fit in 22 columns; the third wraps
+	renderWidget(f, 22, 3)
+	want := [][2]int{{6, 0}, {18, 0}, {6, 1}}
+	for i, ti := range inputs {
+		if b := ti.Bounds(); b.X != want[i][0] || b.Y != want[i][1] {
+			t.Errorf("field %d at (%d, %d), want (%d, %d)", i, b.X, b.Y, want[i][0], want[i][1])
+		}
+	}
+
+	// Too narrow for a field: one per row
+	renderWidget(f, 9, 3)
+	for i, ti := range inputs {
+		if b := ti.Bounds(); b.X != 6 || b.Y != i {
+			t.Errorf("narrow: field %d at (%d, %d), want (6, %d)", i, b.X, b.Y, i)
+		}
+	}
+}
+
+// toggle is a minimal checkbox-like field for form tests
+type toggle struct {
+	BaseWidget
+	on bool
+}
+
+func (t *toggle) Render(buf *screen.Buffer, bounds layout.Rect) { t.bounds = bounds }
+func (t *toggle) HandleEvent(event input.Event) bool            { return false }
+func (t *toggle) Size() layout.Size                             { return layout.NewSize(3, 1) }
+func (t *toggle) MinSize() layout.Size                          { return layout.NewSize(3, 1) }
+func (t *toggle) SetFieldValue(value string)                    { t.on = value == "yes" }
+func (t *toggle) FieldValue() string {
+	if t.on {
+		return "yes"
+	}
+	return "no"
+}
+
+func TestFormValuesMixedFields(t *testing.T) {
+	f := NewForm()
+	f.AddTextInput("Name", "").SetValue("Ada")
+	colors := NewList().SetCardinality(0).SetStrings([]string{"Red", "Green", "Blue"})
+	colors.Select(0).Select(2)
+	f.AddField("Colors", colors)
+	f.AddField("Notes", NewTextArea().SetValue("line one\nline two"))
+	f.AddField("Subscribe", &toggle{BaseWidget: NewBaseWidget(), on: true})
+	f.AddField("Action", NewButton("Go"))
+
+	values := f.Values()
+	want := map[string][]string{
+		"Name":      {"Ada"},
+		"Colors":    {"Red", "Blue"},
+		"Notes":     {"line one\nline two"},
+		"Subscribe": {"yes"},
+	}
+	if len(values) != len(want) {
+		t.Errorf("Values() = %v, want %v", values, want)
+	}
+	for label, w := range want {
+		if got := values[label]; !slices.Equal(got, w) {
+			t.Errorf("Values()[%q] = %q, want %q", label, got, w)
+		}
+	}
+
+	var submitted map[string]string
+	f.OnSubmit(func(values map[string]string) { submitted = values })
+	f.Submit()
+	if submitted["Colors"] != "Red, Blue" || submitted["Subscribe"] != "yes" {
+		t.Errorf("submitted %v, want every Valued field", submitted)
+	}
+}
+
+func TestFormResetAndDirty(t *testing.T) {
+	f := NewForm()
+	name := f.AddTextInput("Name", "")
+	sub := &toggle{BaseWidget: NewBaseWidget()}
+	f.AddField("Subscribe", sub)
+	var changes []bool
+	f.OnDirtyChange(func(dirty bool) { changes = append(changes, dirty) })
+	f.SetDefaults(map[string]string{"Name": "Ada", "Subscribe": "yes"})
+	f.SetFocused(true)
+
+	if name.Value() != "Ada" || !sub.on || f.IsDirty() {
+		t.Fatalf("after SetDefaults: name %q, subscribe %v, dirty %v", name.Value(), sub.on, f.IsDirty())
+	}
+
+	typeText(f, "x")
+	if !f.IsDirty() || len(changes) != 1 || !changes[0] {
+		t.Fatalf("after an edit: dirty %v, changes %v; want dirty reported once", f.IsDirty(), changes)
+	}
+	typeText(f, "y")
+	if len(changes) != 1 {
+		t.Errorf("changes %v, want no report while staying dirty", changes)
+	}
+
+	sub.on = false
+	f.Reset()
+	if name.Value() != "Ada" || !sub.on {
+		t.Errorf("Reset left name %q, subscribe %v; want the defaults", name.Value(), sub.on)
+	}
+	if f.IsDirty() || len(changes) != 2 || changes[1] {
+		t.Errorf("after Reset: dirty %v, changes %v; want clean reported", f.IsDirty(), changes)
+	}
+
+	// Editing back to the default value is clean again
+	f.HandleEvent(input.KeyEvent{Key: input.KeyEnd})
+	f.HandleEvent(input.KeyEvent{Key: input.KeyBackspace})
+	typeText(f, "a")
+	if f.IsDirty() {
+		t.Errorf("form dirty after editing %q back to the default", name.Value())
+	}
+}
+
+func TestFormScrollsToFocusedField(t *testing.T) {
+	f := NewForm().SetShowBorder(true).SetTitle("Long").SetShowScrollbar(true)
+	inputs := make([]*TextInput, 20)
+	for i := range inputs {
+		inputs[i] = f.AddTextInput("Field "+strconv.Itoa(i), "")
+	}
+	f.SetFocused(true)
+
+	// The border and title leave 7 rows for fields
+	for i := 0; i < len(inputs); i++ {
+		buf := renderWidget(f, 30, 10)
+		b := inputs[i].Bounds()
+		if !inputs[i].IsFocused() || b.IsEmpty() || b.Y < 2 || b.Y > 8 {
+			t.Fatalf("focused field %d rendered at %v, want within the view", i, b)
+		}
+		lines := strings.Split(buf.ToString(), "\n")
+		if !strings.Contains(lines[1], "Long") || !strings.HasPrefix(lines[9], "└") {
+			t.Fatalf("field %d: title or border scrolled away:\n%s", i, buf.ToString())
+		}
+		f.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	}
+	if f.offset != 13 {
+		t.Errorf("offset = %d at the last field, want 13", f.offset)
+	}
+
+	for i := 0; i < len(inputs); i++ {
+		f.HandleEvent(input.KeyEvent{Key: input.KeyUp})
+	}
+	renderWidget(f, 30, 10)
+	if f.offset != 0 || inputs[0].Bounds().Y != 2 {
+		t.Errorf("back at the first field: offset %d, field at %v", f.offset, inputs[0].Bounds())
+	}
+}
@@ -0,0 +1,127 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+)
+
+// QuitGuard is implemented by widgets that can hold up quitting the app,
+// e.g. to ask about unsaved changes
+type QuitGuard interface {
+	// BeforeQuit returns true to let the app quit, or false to hold it
+	// up; call quit to try again later, e.g. once the user confirms
+	BeforeQuit(ctx EventContext, quit func()) bool
+}
+
+// UnsavedGuard wraps content with unsaved changes, asking for
+// confirmation before the app quits while it is dirty
+// The content marks itself dirty and clean with SetDirty.
+type UnsavedGuard struct {
+	BaseWidget
+	child   Widget
+	dirty   bool
+	message string
+	asking  bool // The confirmation is showing
+	discard bool // The user agreed to quit without saving
+}
+
+// NewUnsavedGuard creates a clean guard around child
+func NewUnsavedGuard(child Widget) *UnsavedGuard {
+	return &UnsavedGuard{
+		BaseWidget: NewBaseWidget(),
+		child:      child,
+		message:    "Discard unsaved changes and quit?",
+	}
+}
+
+// SetDirty sets whether there are unsaved changes
+func (g *UnsavedGuard) SetDirty(dirty bool) *UnsavedGuard {
+	g.dirty = dirty
+	return g
+}
+
+// IsDirty returns whether there are unsaved changes
+func (g *UnsavedGuard) IsDirty() bool {
+	return g.dirty
+}
+
+// SetMessage sets the question asked before quitting with unsaved changes
+func (g *UnsavedGuard) SetMessage(message string) *UnsavedGuard {
+	g.message = message
+	return g
+}
+
+// Child returns the wrapped widget
+func (g *UnsavedGuard) Child() Widget {
+	return g.child
+}
+
+// Children returns the wrapped widget
+func (g *UnsavedGuard) Children() []Widget {
+	return []Widget{g.child}
+}
+
+// BeforeQuit lets the app quit when clean, and otherwise asks first,
+// quitting if the user agrees
+func (g *UnsavedGuard) BeforeQuit(ctx EventContext, quit func()) bool {
+	if !g.dirty || g.discard {
+		return true
+	}
+	if g.asking {
+		return false
+	}
+	g.asking = true
+	ctx.Confirm(g.message, func(ok bool) {
+		g.asking = false
+		if ok {
+			g.discard = true
+			quit()
+			g.discard = false
+		}
+	})
+	return false
+}
+
+// Render draws the child
+func (g *UnsavedGuard) Render(buf *screen.Buffer, bounds layout.Rect) {
+	g.bounds = bounds
+	if !g.visible || bounds.IsEmpty() {
+		return
+	}
+	g.child.Render(buf, bounds)
+}
+
+// HandleEvent passes events to the child
+func (g *UnsavedGuard) HandleEvent(event input.Event) bool {
+	return g.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx passes events to the child along with ctx
+func (g *UnsavedGuard) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !g.visible {
+		return false
+	}
+	return HandleEventCtx(g.child, ctx, event)
+}
+
+// SetFocused sets the focus state of the guard and the child
+func (g *UnsavedGuard) SetFocused(focused bool) {
+	g.BaseWidget.SetFocused(focused)
+	g.child.SetFocused(focused)
+}
+
+// IsInteractive returns whether the child can receive input
+func (g *UnsavedGuard) IsInteractive() bool {
+	return g.child.IsInteractive()
+}
+
+// Size returns the preferred size of the child
+func (g *UnsavedGuard) Size() layout.Size {
+	return g.child.Size()
+}
+
+// MinSize returns the minimum size of the child
+func (g *UnsavedGuard) MinSize() layout.Size {
+	return g.child.MinSize()
+}
@@ -0,0 +1,258 @@
+package widget
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// heatmapLegendSwatches is how many colors the legend shows
+const heatmapLegendSwatches = 5
+
+// Heatmap draws a grid of values as colored cells
+// Colors run from a low to a high color across the scale, which fits the
+// data unless SetColorScale fixes it. NaN values are missing and drawn in
+// a neutral color. SetCalendar lays out daily values like an activity
+// calendar, one column per week.
+type Heatmap struct {
+	BaseWidget
+	values      [][]float64 // Rows of values
+	min         float64
+	max         float64
+	autoScale   bool
+	low         terminal.RGB
+	high        terminal.RGB
+	ramp        []terminal.Color // When set, overrides low and high
+	missing     terminal.Color
+	cellWidth   int
+	showLegend  bool
+	legendStyle terminal.Style
+}
+
+// NewHeatmap creates a heatmap of values, given as rows, with an automatic
+// scale from dark to bright green
+func NewHeatmap(values [][]float64) *Heatmap {
+	return &Heatmap{
+		BaseWidget:  NewBaseWidget(),
+		values:      values,
+		autoScale:   true,
+		low:         terminal.Hex(0x0e4429),
+		high:        terminal.Hex(0x39d353),
+		missing:     terminal.Color256(236),
+		cellWidth:   2,
+		showLegend:  true,
+		legendStyle: terminal.DefaultStyle().WithDim(),
+	}
+}
+
+// SetValues sets the values, given as rows
+func (h *Heatmap) SetValues(values [][]float64) *Heatmap {
+	h.values = values
+	return h
+}
+
+// Values returns the values as rows
+func (h *Heatmap) Values() [][]float64 {
+	return h.values
+}
+
+// SetCalendar lays out one value per day for the given number of weeks up
+// to end, with a row per weekday from Sunday and a column per week
+// Days without a value, and days after end, are missing.
+func (h *Heatmap) SetCalendar(days map[time.Time]float64, end time.Time, weeks int) *Heatmap {
+	byDate := make(map[[3]int]float64, len(days))
+	for day, value := range days {
+		y, m, d := day.Date()
+		byDate[[3]int{y, int(m), d}] = value
+	}
+
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+	start := end.AddDate(0, 0, -int(end.Weekday())-7*(weeks-1))
+	h.values = make([][]float64, 7)
+	for weekday := range h.values {
+		h.values[weekday] = make([]float64, weeks)
+		for week := range h.values[weekday] {
+			day := start.AddDate(0, 0, 7*week+weekday)
+			y, m, d := day.Date()
+			value, ok := byDate[[3]int{y, int(m), d}]
+			if !ok || day.After(end) {
+				value = math.NaN()
+			}
+			h.values[weekday][week] = value
+		}
+	}
+	return h
+}
+
+// SetColorScale fixes the scale, coloring min as low and max as high
+// Values outside it are clamped.
+func (h *Heatmap) SetColorScale(min, max float64, low, high terminal.RGB) *Heatmap {
+	h.min, h.max = min, max
+	h.low, h.high = low, high
+	h.autoScale = false
+	h.ramp = nil
+	return h
+}
+
+// AutoColorScale fits the scale to the data again
+func (h *Heatmap) AutoColorScale() *Heatmap {
+	h.autoScale = true
+	return h
+}
+
+// SetRamp colors values with evenly spaced steps of colors, e.g. from the
+// 256-color palette, instead of blending from low to high
+func (h *Heatmap) SetRamp(colors []terminal.Color) *Heatmap {
+	h.ramp = colors
+	return h
+}
+
+// SetMissingColor sets the color of missing (NaN) values
+func (h *Heatmap) SetMissingColor(color terminal.Color) *Heatmap {
+	h.missing = color
+	return h
+}
+
+// SetCellWidth sets how many columns each value takes
+func (h *Heatmap) SetCellWidth(width int) *Heatmap {
+	h.cellWidth = max(1, width)
+	return h
+}
+
+// SetShowLegend sets whether a legend row is drawn below the grid
+func (h *Heatmap) SetShowLegend(show bool) *Heatmap {
+	h.showLegend = show
+	return h
+}
+
+// SetLegendStyle sets the style of the legend text
+func (h *Heatmap) SetLegendStyle(style terminal.Style) *Heatmap {
+	h.legendStyle = style
+	return h
+}
+
+// scale returns the range values are colored over
+func (h *Heatmap) scale() (float64, float64) {
+	if !h.autoScale {
+		return h.min, h.max
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, row := range h.values {
+		for _, v := range row {
+			if !math.IsNaN(v) {
+				lo, hi = math.Min(lo, v), math.Max(hi, v)
+			}
+		}
+	}
+	if lo > hi {
+		return 0, 0
+	}
+	return lo, hi
+}
+
+// ColorFor returns the color value is drawn in
+func (h *Heatmap) ColorFor(value float64) terminal.Color {
+	if math.IsNaN(value) {
+		return h.missing
+	}
+	lo, hi := h.scale()
+	t := 0.0
+	if hi > lo {
+		t = math.Max(0, math.Min(1, (value-lo)/(hi-lo)))
+	}
+	return h.colorAt(t)
+}
+
+// colorAt returns the color t of the way along the scale
+func (h *Heatmap) colorAt(t float64) terminal.Color {
+	if len(h.ramp) > 0 {
+		return h.ramp[int(math.Round(t*float64(len(h.ramp)-1)))]
+	}
+	return h.low.Lerp(h.high, t)
+}
+
+// gridSize returns the number of columns and rows of values
+func (h *Heatmap) gridSize() (cols, rows int) {
+	for _, row := range h.values {
+		cols = max(cols, len(row))
+	}
+	return cols, len(h.values)
+}
+
+// legend returns the text either side of the legend swatches
+func (h *Heatmap) legend() (string, string) {
+	lo, hi := h.scale()
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'g', 3, 64)
+	}
+	return format(lo) + " ", " " + format(hi)
+}
+
+// Render draws the grid, and the legend below it if shown
+func (h *Heatmap) Render(buf *screen.Buffer, bounds layout.Rect) {
+	h.bounds = bounds
+	if !h.visible || bounds.IsEmpty() {
+		return
+	}
+
+	gridHeight := bounds.Height
+	if h.showLegend {
+		gridHeight--
+	}
+	for y, row := range h.values {
+		if y >= gridHeight {
+			break
+		}
+		for x, value := range row {
+			cx := bounds.X + x*h.cellWidth
+			width := min(h.cellWidth, bounds.X+bounds.Width-cx)
+			if width <= 0 {
+				break
+			}
+			style := terminal.DefaultStyle().WithBG(h.ColorFor(value))
+			buf.FillRect(cx, bounds.Y+y, bounds.Z, width, 1, screen.NewCell(' ', style))
+		}
+	}
+
+	if !h.showLegend {
+		return
+	}
+	_, rows := h.gridSize()
+	y := bounds.Y + min(rows, gridHeight)
+	before, after := h.legend()
+	buf.DrawStringClipped(bounds.X, y, bounds.Z, before, h.legendStyle, bounds.Width)
+	x := bounds.X + screen.DisplayWidth(before)
+	for i := 0; i < heatmapLegendSwatches && x < bounds.X+bounds.Width; i++ {
+		color := h.colorAt(float64(i) / (heatmapLegendSwatches - 1))
+		buf.Set(x, y, bounds.Z, screen.NewCell(' ', terminal.DefaultStyle().WithBG(color)))
+		x++
+	}
+	buf.DrawStringClipped(x, y, bounds.Z, after, h.legendStyle, bounds.X+bounds.Width-x)
+}
+
+// HandleEvent does nothing; heatmaps are display only
+func (h *Heatmap) HandleEvent(event input.Event) bool {
+	return false
+}
+
+// Size returns the size of the grid and legend
+func (h *Heatmap) Size() layout.Size {
+	cols, rows := h.gridSize()
+	width := cols * h.cellWidth
+	if h.showLegend {
+		before, after := h.legend()
+		width = max(width, screen.DisplayWidth(before)+heatmapLegendSwatches+screen.DisplayWidth(after))
+		rows++
+	}
+	return layout.NewSize(width, rows)
+}
+
+// MinSize returns the minimum size
+func (h *Heatmap) MinSize() layout.Size {
+	return layout.NewSize(h.cellWidth, 1)
+}
@@ -0,0 +1,98 @@
+package widget
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestHeatmapColorScale(t *testing.T) {
+	black, white := terminal.RGB{}, terminal.RGB{R: 255, G: 255, B: 255}
+	h := NewHeatmap(nil).SetColorScale(0, 10, black, white)
+	tests := []struct {
+		value float64
+		want  terminal.Color
+	}{
+		{0, black},
+		{10, white},
+		{-5, black}, // Clamped
+		{20, white},
+		{5, black.Lerp(white, 0.5)},
+		{math.NaN(), terminal.Color256(236)},
+	}
+	for _, tt := range tests {
+		if got := h.ColorFor(tt.value); got != tt.want {
+			t.Errorf("ColorFor(%v) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	ramp := []terminal.Color{terminal.Color256(22), terminal.Color256(28), terminal.Color256(34)}
+	h.SetRamp(ramp)
+	for value, want := range map[float64]terminal.Color{0: ramp[0], 5: ramp[1], 10: ramp[2]} {
+		if got := h.ColorFor(value); got != want {
+			t.Errorf("with a ramp ColorFor(%v) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestHeatmapAutoScale(t *testing.T) {
+	black, white := terminal.RGB{}, terminal.RGB{R: 255, G: 255, B: 255}
+	h := NewHeatmap([][]float64{{2, math.NaN()}, {4, 6}}).SetColorScale(0, 1, black, white).AutoColorScale()
+	if got := h.ColorFor(2); got != black {
+		t.Errorf("ColorFor(min) = %v, want %v", got, black)
+	}
+	if got := h.ColorFor(6); got != white {
+		t.Errorf("ColorFor(max) = %v, want %v", got, white)
+	}
+}
+
+func TestHeatmapLayout(t *testing.T) {
+	h := NewHeatmap([][]float64{{0, 1, 2}, {3, 4}}).SetShowLegend(false)
+	if got, want := h.Size(), layout.NewSize(6, 2); got != want {
+		t.Errorf("Size() = %v, want %v", got, want)
+	}
+
+	buf := renderWidget(h, 6, 2)
+	if got, want := buf.Get(4, 0, 0).Style.BG, h.ColorFor(2); got != want {
+		t.Errorf("cell (2, 0) drawn in %v, want %v", got, want)
+	}
+	if got := buf.Get(4, 1, 0).Style.BG; got == h.ColorFor(2) {
+		t.Error("short row drawn past its end")
+	}
+
+	h.SetShowLegend(true)
+	if got, want := h.Size(), layout.NewSize(len("0 ")+heatmapLegendSwatches+len(" 4"), 3); got != want {
+		t.Errorf("Size() with legend = %v, want %v", got, want)
+	}
+	buf = renderWidget(h, 9, 3)
+	if got, want := strings.Split(buf.ToString(), "\n")[2], "0       4"; got != want {
+		t.Errorf("legend row = %q, want %q", got, want)
+	}
+	if got, want := buf.Get(2, 2, 0).Style.BG, h.ColorFor(0); got != want {
+		t.Errorf("first legend swatch drawn in %v, want %v", got, want)
+	}
+}
+
+func TestHeatmapCalendar(t *testing.T) {
+	end := time.Date(2024, 3, 13, 15, 0, 0, 0, time.UTC) // A Wednesday
+	days := map[time.Time]float64{
+		time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC):  1, // Sunday of the first week
+		time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC): 2,
+	}
+	h := NewHeatmap(nil).SetCalendar(days, end, 2)
+
+	values := h.Values()
+	if len(values) != 7 || len(values[0]) != 2 {
+		t.Fatalf("calendar is %d rows of %d, want 7 of 2", len(values), len(values[0]))
+	}
+	if values[0][0] != 1 || values[3][1] != 2 {
+		t.Errorf("values[0][0], values[3][1] = %v, %v, want 1, 2", values[0][0], values[3][1])
+	}
+	if !math.IsNaN(values[1][0]) || !math.IsNaN(values[4][1]) {
+		t.Error("days without a value, or after end, not missing")
+	}
+}
@@ -0,0 +1,31 @@
+package widget
+
+// HelpEntry describes a key and what it does
+type HelpEntry struct {
+	Keys        string
+	Description string
+}
+
+// HelpProvider is implemented by widgets that list their keys in the help
+type HelpProvider interface {
+	// HelpEntries returns the widget's keys
+	HelpEntries() []HelpEntry
+}
+
+// FocusedHelp returns the help entries of the focused HelpProviders within
+// root, outermost first
+func FocusedHelp(root Widget) []HelpEntry {
+	if root == nil {
+		return nil
+	}
+	var entries []HelpEntry
+	if provider, ok := root.(HelpProvider); ok && root.IsFocused() {
+		entries = append(entries, provider.HelpEntries()...)
+	}
+	if parent, ok := root.(interface{ Children() []Widget }); ok {
+		for _, child := range parent.Children() {
+			entries = append(entries, FocusedHelp(child)...)
+		}
+	}
+	return entries
+}
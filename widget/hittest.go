@@ -0,0 +1,86 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+)
+
+// HitTest returns the topmost widget at (x, y) within root, or nil if root
+// wasn't rendered there
+// Widgets are found through Children using the bounds they were last
+// rendered in; a child on a higher z-layer wins, then the last drawn
+func HitTest(root Widget, x, y int) Widget {
+	path := HitPath(root, x, y)
+	if len(path) == 0 {
+		return nil
+	}
+	return path[len(path)-1]
+}
+
+// HitPath returns the widgets containing (x, y), from root down to the
+// topmost one, or nil if root wasn't rendered there
+func HitPath(root Widget, x, y int) []Widget {
+	var path []Widget
+	for w := root; w != nil; {
+		bounds, ok := renderedBounds(w)
+		if !ok || !bounds.Contains(x, y) || !isVisible(w) {
+			break
+		}
+		path = append(path, w)
+		w = hitChild(w, x, y)
+	}
+	return path
+}
+
+// hitChild returns the child of w at (x, y), preferring higher z-layers and
+// then later children, or nil if there is none
+func hitChild(w Widget, x, y int) Widget {
+	parent, ok := w.(interface{ Children() []Widget })
+	if !ok {
+		return nil
+	}
+	var hit Widget
+	hitZ := 0
+	for _, child := range parent.Children() {
+		bounds, ok := renderedBounds(child)
+		if !ok || !bounds.Contains(x, y) || !isVisible(child) {
+			continue
+		}
+		if hit == nil || bounds.Z >= hitZ {
+			hit, hitZ = child, bounds.Z
+		}
+	}
+	return hit
+}
+
+// isVisible returns whether w is visible, treating widgets without a
+// visibility state as visible
+func isVisible(w Widget) bool {
+	if v, ok := w.(interface{ IsVisible() bool }); ok {
+		return v.IsVisible()
+	}
+	return true
+}
+
+// ResetBounds clears the recorded bounds of w and every descendant reachable
+// through Children
+// Called before a render so widgets that aren't drawn, such as inactive
+// tabs, can't be hit through bounds left over from an earlier frame
+func ResetBounds(w Widget) {
+	if b, ok := w.(interface{ SetBounds(layout.Rect) }); ok {
+		b.SetBounds(layout.Rect{})
+	}
+	if parent, ok := w.(interface{ Children() []Widget }); ok {
+		for _, child := range parent.Children() {
+			ResetBounds(child)
+		}
+	}
+}
+
+// LocalMouseEvent translates a mouse event into coordinates relative to the
+// top-left corner of bounds
+func LocalMouseEvent(e input.MouseEvent, bounds layout.Rect) input.MouseEvent {
+	e.X -= bounds.X
+	e.Y -= bounds.Y
+	return e
+}
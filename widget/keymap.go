@@ -0,0 +1,199 @@
+package widget
+
+import "github.com/agiles231/gotui/input"
+
+// EditAction is an editing command a key is bound to
+type EditAction int
+
+const (
+	// EditNone leaves the key unhandled, for the parent to use
+	EditNone EditAction = iota
+	// EditHandled uses the key without changing anything, e.g. to switch
+	// modes
+	EditHandled
+	// EditInsert types the key's rune
+	EditInsert
+	EditLeft
+	EditRight
+	EditWordLeft
+	EditWordRight
+	// EditUp and EditDown move between lines; single-line widgets leave
+	// them unhandled, for the parent to use
+	EditUp
+	EditDown
+	EditHome
+	EditEnd
+	EditBackspace
+	EditDelete
+	EditKillToEnd
+	EditKillToStart
+	EditKillWord
+	EditUndo
+	EditRedo
+	// EditTab inserts indentation if the widget takes Tab
+	EditTab
+	// EditSubmit submits the text
+	EditSubmit
+)
+
+// EditKeymap decides what the keys do in a text editing widget
+// A keymap may keep state, like the Vi mode, so each widget needs its own.
+type EditKeymap interface {
+	// Action returns the editing action for e
+	Action(e input.KeyEvent) EditAction
+}
+
+// EmacsKeymap is the default editing keymap: keys type themselves, and
+// Ctrl+A/E move to the start and end, Ctrl+K/U kill to the end and start,
+// Ctrl+W kills a word, Ctrl+Z undoes and Ctrl+Y or Ctrl+Shift+Z redoes
+type EmacsKeymap struct{}
+
+// Action returns the editing action for e
+func (EmacsKeymap) Action(e input.KeyEvent) EditAction {
+	if e.Key == input.KeyRune && e.IsCtrl() {
+		switch e.Rune {
+		case 'a':
+			return EditHome
+		case 'e':
+			return EditEnd
+		case 'k':
+			return EditKillToEnd
+		case 'u':
+			return EditKillToStart
+		case 'w':
+			return EditKillWord
+		case 'z':
+			if e.IsShift() {
+				return EditRedo
+			}
+			return EditUndo
+		case 'y':
+			return EditRedo
+		}
+		return EditNone
+	}
+
+	switch e.Key {
+	case input.KeyRune:
+		return EditInsert
+	case input.KeyBackspace:
+		return EditBackspace
+	case input.KeyDelete:
+		return EditDelete
+	case input.KeyTab:
+		if e.Modifier == input.ModNone {
+			return EditTab
+		}
+	case input.KeyLeft:
+		if e.IsCtrl() {
+			return EditWordLeft
+		}
+		return EditLeft
+	case input.KeyRight:
+		if e.IsCtrl() {
+			return EditWordRight
+		}
+		return EditRight
+	case input.KeyUp:
+		return EditUp
+	case input.KeyDown:
+		return EditDown
+	case input.KeyHome:
+		return EditHome
+	case input.KeyEnd:
+		return EditEnd
+	case input.KeyEnter:
+		return EditSubmit
+	}
+	return EditNone
+}
+
+// ViKeymap is a modal editing keymap
+// It starts in normal mode, where h/l move, j/k move down and up a line,
+// w/b move by word, 0/^ and $ go to the start and end, x/X delete, D kills
+// to the end, u undoes and Ctrl+R redoes. i, a, I and A switch to insert
+// mode, where keys work as in EmacsKeymap until Escape returns to normal
+// mode.
+type ViKeymap struct {
+	insert bool
+}
+
+// NewViKeymap creates a Vi keymap in normal mode
+func NewViKeymap() *ViKeymap {
+	return &ViKeymap{}
+}
+
+// InsertMode returns true in insert mode
+func (v *ViKeymap) InsertMode() bool {
+	return v.insert
+}
+
+// SetInsertMode switches between insert and normal mode
+func (v *ViKeymap) SetInsertMode(insert bool) *ViKeymap {
+	v.insert = insert
+	return v
+}
+
+// Action returns the editing action for e in the current mode
+func (v *ViKeymap) Action(e input.KeyEvent) EditAction {
+	if v.insert {
+		if e.Key == input.KeyEscape {
+			v.insert = false
+			return EditLeft
+		}
+		return EmacsKeymap{}.Action(e)
+	}
+
+	if e.Key != input.KeyRune {
+		if e.Key == input.KeyEscape || e.Key == input.KeyTab {
+			return EditNone
+		}
+		return EmacsKeymap{}.Action(e)
+	}
+	if e.IsCtrl() {
+		if e.Rune == 'r' {
+			return EditRedo
+		}
+		return EditNone
+	}
+
+	switch e.Rune {
+	case 'h':
+		return EditLeft
+	case 'l':
+		return EditRight
+	case 'j':
+		return EditDown
+	case 'k':
+		return EditUp
+	case 'w':
+		return EditWordRight
+	case 'b':
+		return EditWordLeft
+	case '0', '^':
+		return EditHome
+	case '$':
+		return EditEnd
+	case 'x':
+		return EditDelete
+	case 'X':
+		return EditBackspace
+	case 'D':
+		return EditKillToEnd
+	case 'u':
+		return EditUndo
+	case 'i':
+		v.insert = true
+		return EditHandled
+	case 'a':
+		v.insert = true
+		return EditRight
+	case 'I':
+		v.insert = true
+		return EditHome
+	case 'A':
+		v.insert = true
+		return EditEnd
+	}
+	return EditHandled
+}
@@ -0,0 +1,71 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestViKeymapTextInput(t *testing.T) {
+	vi := NewViKeymap()
+	ti := NewTextInput().SetKeymap(vi).SetValue("hllo")
+	ti.SetFocused(true)
+
+	typeText(ti, "q") // Not a command; ignored in normal mode
+	if ti.Value() != "hllo" || ti.cursor != 0 {
+		t.Fatalf("q in normal mode: value, cursor = %q, %d, want %q, 0", ti.Value(), ti.cursor, "hllo")
+	}
+
+	typeText(ti, "lie")
+	if !vi.InsertMode() || ti.Value() != "hello" || ti.cursor != 2 {
+		t.Fatalf("after l, i, e: insert, value, cursor = %v, %q, %d, want true, %q, 2",
+			vi.InsertMode(), ti.Value(), ti.cursor, "hello")
+	}
+
+	ti.HandleEvent(input.KeyEvent{Key: input.KeyEscape})
+	if vi.InsertMode() || ti.cursor != 1 {
+		t.Fatalf("after Escape: insert, cursor = %v, %d, want false, 1", vi.InsertMode(), ti.cursor)
+	}
+
+	typeText(ti, "$X")
+	if ti.Value() != "hell" {
+		t.Errorf("after $, X: value = %q, want %q", ti.Value(), "hell")
+	}
+	typeText(ti, "u")
+	if ti.Value() != "hello" {
+		t.Errorf("after u: value = %q, want %q", ti.Value(), "hello")
+	}
+	typeText(ti, "A!")
+	if ti.Value() != "hello!" || ti.cursor != 6 {
+		t.Errorf("after A, !: value, cursor = %q, %d, want %q, 6", ti.Value(), ti.cursor, "hello!")
+	}
+}
+
+func TestViKeymapTextArea(t *testing.T) {
+	ta := NewTextArea().SetKeymap(NewViKeymap()).SetValue("abc\ndef")
+	ta.SetFocused(true)
+
+	typeText(ta, "jll")
+	if line, col := ta.Cursor(); line != 1 || col != 2 {
+		t.Fatalf("after j, l, l: cursor = %d, %d, want 1, 2", line, col)
+	}
+	typeText(ta, "kD")
+	if ta.Value() != "ab\ndef" {
+		t.Errorf("after k, D: value = %q, want %q", ta.Value(), "ab\ndef")
+	}
+	typeText(ta, "0iX")
+	ta.HandleEvent(input.KeyEvent{Key: input.KeyEscape})
+	if ta.Value() != "Xab\ndef" {
+		t.Errorf("after 0, i, X, Escape: value = %q, want %q", ta.Value(), "Xab\ndef")
+	}
+}
+
+func TestEmacsKeymapIsDefault(t *testing.T) {
+	ti := NewTextInput().SetValue("hello")
+	ti.SetFocused(true)
+	ti.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'a', Modifier: input.ModCtrl})
+	typeText(ti, "j")
+	if ti.Value() != "jhello" {
+		t.Errorf("after Ctrl+A, j: value = %q, want %q", ti.Value(), "jhello")
+	}
+}
@@ -6,18 +6,24 @@ import (
 	"github.com/agiles231/gotui/screen"
 	"github.com/agiles231/gotui/terminal"
 
+	"fmt"
 	"slices"
+	"strings"
+	"time"
 )
 
 // ListItem represents an item in a list
 type ListItem struct {
-	Text  string
-	Value interface{}
+	Text    string
+	Value   interface{}
+	Matches []int // Rune indexes of Text to highlight, ascending, e.g. from text.FuzzyMatch
 }
 
 // List is a scrollable list widget
 type List struct {
 	BaseWidget
+	background    background
+	zebra         zebra
 	items         []ListItem
 	offset        int // Scroll offset
 	cursor        int
@@ -26,10 +32,20 @@ type List struct {
 	style         terminal.Style
 	selectedStyle terminal.Style
 	cursorStyle   terminal.Style
+	matchStyle    terminal.Style
 	height        int
 	showBorder    bool
-	onSelect      func(index int, item ListItem)
-	onChange      func(index int, item ListItem)
+	wrap          bool // Up at the top and Down at the bottom wrap around
+	ellipsis      bool
+	emptyText     string
+	wheel         wheelScroll
+	clicks        clickTracker
+	scrollbar     *Scrollbar
+	typeAhead     typeAhead
+	scrolled      scrollNotifier
+	onSelect      func(index int, item ListItem) // Selection toggled
+	onActivate    func(index int, item ListItem) // Enter or double-click
+	onChange      func(index int, item ListItem) // Cursor moved
 }
 
 // NewList creates a new list widget
@@ -39,8 +55,13 @@ func NewList() *List {
 		style:         terminal.DefaultStyle(),
 		selectedStyle: terminal.DefaultStyle().WithBG(terminal.ColorGreen),
 		cursorStyle:   terminal.DefaultStyle().WithReverse(),
+		matchStyle:    terminal.DefaultStyle().WithFG(terminal.ColorYellow).WithBold(),
 		height:        10,
 		cardinality:   1,
+		wheel:         newWheelScroll(),
+		clicks:        newClickTracker(),
+		scrollbar:     NewScrollbar(layout.Vertical),
+		typeAhead:     newTypeAhead(),
 	}
 	l.SetInteractive(true)
 	return l
@@ -116,7 +137,7 @@ func (l *List) Selected() []int {
 // SelectedItem returns the selected item
 func (l *List) SelectedItems() []*ListItem {
 	if l.selected != nil && len(l.selected) > 0 {
-		selectedItems := make([]*ListItem, len(l.selected))
+		selectedItems := make([]*ListItem, 0, len(l.selected))
 		for _, sel := range l.selected {
 			selectedItems = append(selectedItems, &l.items[sel])
 		}
@@ -124,6 +145,41 @@ func (l *List) SelectedItems() []*ListItem {
 	}
 	return nil
 }
+
+// ListValueSeparator separates the texts of selected items in a List's
+// field value
+// Items are single lines, so their texts, commas included, round-trip
+// through FieldValue and SetFieldValue.
+const ListValueSeparator = "\n"
+
+// FieldValue returns the text of the selected items, joined with
+// ListValueSeparator
+func (l *List) FieldValue() string {
+	texts := make([]string, 0, len(l.selected))
+	for _, item := range l.SelectedItems() {
+		texts = append(texts, item.Text)
+	}
+	return strings.Join(texts, ListValueSeparator)
+}
+
+// SetFieldValue selects the items whose text is listed in value, joined
+// with ListValueSeparator, clearing the previous selection
+// Each text selects the first unselected item with exactly that text.
+func (l *List) SetFieldValue(value string) {
+	l.selected = nil
+	if value == "" {
+		return
+	}
+	for _, text := range strings.Split(value, ListValueSeparator) {
+		for i, item := range l.items {
+			if selected, _ := l.isIndexSelected(i); item.Text == text && !selected {
+				l.Select(i)
+				break
+			}
+		}
+	}
+}
+
 // // Selected returns the selected index
 // func (l *List) Selected() int {
 // 	return l.selected
@@ -163,6 +219,13 @@ func (l *List) Select(index int) *List {
 	return l
 }
 
+// SetCursor moves the cursor to index, clamped to the items
+func (l *List) SetCursor(index int) *List {
+	l.cursor = max(0, min(index, len(l.items)-1))
+	l.ensureVisible()
+	return l
+}
+
 // SetHeight sets the visible height
 func (l *List) SetHeight(height int) *List {
 	l.height = height
@@ -187,29 +250,116 @@ func (l *List) SetCursorStyle(style terminal.Style) *List {
 	return l
 }
 
+// SetMatchStyle sets the style of the highlighted runes in ListItem.Matches
+// On the cursor and selected rows they are drawn bold and underlined in
+// the row's style instead, to stay readable on its background
+func (l *List) SetMatchStyle(style terminal.Style) *List {
+	l.matchStyle = style
+	return l
+}
+
 // SetShowBorder enables or disables the border
 func (l *List) SetShowBorder(show bool) *List {
 	l.showBorder = show
 	return l
 }
 
-// OnSelect sets the callback for Enter key
+// SetWrapSelection makes Up at the first item move to the last and Down at
+// the last item move to the first
+func (l *List) SetWrapSelection(wrap bool) *List {
+	l.wrap = wrap
+	return l
+}
+
+// SetTypeAhead enables jumping to the next item starting with the letters
+// typed in quick succession, ignoring case and wrapping around
+// Add the list to the app's tickers so a pause starts a new search even
+// without another key.
+func (l *List) SetTypeAhead(enabled bool) *List {
+	l.typeAhead.enabled = enabled
+	return l
+}
+
+// SetEmptyText sets the placeholder shown when the list has no items
+func (l *List) SetEmptyText(text string) *List {
+	l.emptyText = text
+	return l
+}
+
+// SetEllipsis enables ending truncated item text with an ellipsis
+func (l *List) SetEllipsis(ellipsis bool) *List {
+	l.ellipsis = ellipsis
+	return l
+}
+
+// SetWheelStep sets how many lines one mouse wheel notch scrolls
+func (l *List) SetWheelStep(lines int) *List {
+	l.wheel.step = max(1, lines)
+	return l
+}
+
+// SetWheelMovesCursor sets whether the mouse wheel moves the cursor along
+// with the view, rather than only scrolling it
+func (l *List) SetWheelMovesCursor(move bool) *List {
+	l.wheel.moveCursor = move
+	return l
+}
+
+// OnHighlight sets the callback for the cursor moving to another item
+func (l *List) OnHighlight(fn func(index int, item ListItem)) *List {
+	l.onChange = fn
+	return l
+}
+
+// OnChange is the older name of OnHighlight
+func (l *List) OnChange(fn func(index int, item ListItem)) *List {
+	return l.OnHighlight(fn)
+}
+
+// OnSelect sets the callback for an item being selected or deselected,
+// by Space or a click
+// Enter used to call it; it now calls OnActivate.
 func (l *List) OnSelect(fn func(index int, item ListItem)) *List {
 	l.onSelect = fn
 	return l
 }
 
-// OnChange sets the callback for selection change
-func (l *List) OnChange(fn func(index int, item ListItem)) *List {
-	l.onChange = fn
+// OnActivate sets the callback for Enter or a double-click on an item
+func (l *List) OnActivate(fn func(index int, item ListItem)) *List {
+	l.onActivate = fn
+	return l
+}
+
+// OnScroll sets the callback for the view scrolling, with the index of the
+// first item shown, how many items fit and how many there are
+// Loading more items once offset+visible nears total gives infinite
+// scrolling.
+func (l *List) OnScroll(fn func(offset, visible, total int)) *List {
+	l.scrolled.fn = fn
+	return l
+}
+
+// SetZebra stripes items, alternating between the even and odd styles by
+// item index; the cursor and selection styles still take precedence
+func (l *List) SetZebra(even, odd terminal.Style) *List {
+	l.zebra.set(even, odd)
+	return l
+}
+
+// SetBackground fills the whole bounds with spaces in style before drawing
+// the content; by default only the rows with content are drawn
+func (l *List) SetBackground(style terminal.Style) *List {
+	l.background.set(style)
 	return l
 }
 
 // Render draws the list
 func (l *List) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !l.visible {
+	l.bounds = bounds
+	if !l.visible || bounds.IsEmpty() {
 		return
 	}
+	l.background.fill(buf, bounds)
 
 	innerBounds := bounds
 	if l.showBorder {
@@ -222,6 +372,12 @@ func (l *List) Render(buf *screen.Buffer, bounds layout.Rect) {
 		visibleHeight = l.height
 	}
 
+	if len(l.items) == 0 {
+		innerBounds.Height = visibleHeight
+		drawEmptyText(buf, innerBounds, l.emptyText, l.style)
+		return
+	}
+
 	for i := 0; i < visibleHeight; i++ {
 		itemIndex := l.offset + i
 		if itemIndex >= len(l.items) {
@@ -229,12 +385,15 @@ func (l *List) Render(buf *screen.Buffer, bounds layout.Rect) {
 		}
 
 		item := l.items[itemIndex]
-		style := l.style
+		style := l.zebra.style(itemIndex, l.style)
+		matchStyle := l.matchStyle
 		if itemIndex == l.cursor && l.focused {
 			style = l.cursorStyle
+			matchStyle = style.WithBold().WithUnderline()
 		}
 		if selected, _ := l.isIndexSelected(itemIndex); selected {
 			style = l.selectedStyle
+			matchStyle = style.WithBold().WithUnderline()
 		}
 		
 
@@ -244,13 +403,22 @@ func (l *List) Render(buf *screen.Buffer, bounds layout.Rect) {
 		}
 
 		// Draw item text
-		buf.DrawStringClipped(innerBounds.X, innerBounds.Y+i, innerBounds.Z, item.Text, style, innerBounds.Width)
+		text := screen.Truncate(item.Text, innerBounds.Width, l.ellipsis)
+		if len(item.Matches) > 0 {
+			matches := item.Matches
+			if text != item.Text && l.ellipsis {
+				// Don't highlight the ellipsis
+				matches = slices.DeleteFunc(slices.Clone(matches), func(i int) bool { return i >= len([]rune(text))-1 })
+			}
+			buf.DrawStringHighlighted(innerBounds.X, innerBounds.Y+i, innerBounds.Z, text, style, matchStyle, matches, innerBounds.Width)
+		} else {
+			buf.DrawStringClipped(innerBounds.X, innerBounds.Y+i, innerBounds.Z, text, style, innerBounds.Width)
+		}
 	}
 
 	// Draw scrollbar if needed
-	if len(l.items) > visibleHeight {
-		l.drawScrollbar(buf, innerBounds, visibleHeight)
-	}
+	scrollBounds := layout.NewRect(innerBounds.X+innerBounds.Width-1, innerBounds.Y, innerBounds.Z, 1, visibleHeight)
+	l.scrollbar.Render(buf, scrollBounds, len(l.items), visibleHeight, l.offset)
 }
 
 func (l *List) isIndexSelected(i int) (bool, int) {
@@ -262,34 +430,22 @@ func (l *List) isIndexSelected(i int) (bool, int) {
 	return false, 0
 }
 
-// drawScrollbar draws a scrollbar
-func (l *List) drawScrollbar(buf *screen.Buffer, bounds layout.Rect, visibleHeight int) {
-	if visibleHeight <= 0 || len(l.items) <= visibleHeight {
-		return
-	}
-
-	scrollX := bounds.X + bounds.Width - 1
-	
-	// Calculate thumb position and size
-	thumbSize := max(1, (visibleHeight*visibleHeight)/len(l.items))
-	thumbPos := (l.offset * (visibleHeight - thumbSize)) / (len(l.items) - visibleHeight)
-
-	scrollStyle := terminal.DefaultStyle().WithDim()
-	thumbStyle := terminal.DefaultStyle().WithReverse()
-
-	for y := 0; y < visibleHeight; y++ {
-		style := scrollStyle
-		char := '│'
-		if y >= thumbPos && y < thumbPos+thumbSize {
-			style = thumbStyle
-			char = '█'
-		}
-		buf.Set(scrollX, bounds.Y+y, bounds.Z, screen.NewCell(char, style))
-	}
-}
-
 // HandleEvent handles input events
 func (l *List) HandleEvent(event input.Event) bool {
+	if e, ok := event.(input.MouseEvent); ok {
+		if offset, ok := l.scrollbar.Drag(e, l.bounds); ok {
+			l.offset = offset
+			l.notifyScroll()
+			return true
+		}
+	}
+	if lines := l.wheel.delta(event, l.bounds); lines != 0 {
+		l.scrollBy(lines)
+		return true
+	}
+	if e, ok := leftPress(event); ok {
+		return l.click(e)
+	}
 	if !l.focused {
 		return false
 	}
@@ -321,30 +477,79 @@ func (l *List) HandleEvent(event input.Event) bool {
 		l.notifyChange()
 		return true
 	case input.KeyEnter:
-		if l.onSelect != nil && l.cursor < len(l.items) {
-			l.Select(l.cursor)
-			l.onSelect(l.cursor, l.items[l.cursor])
+		if l.cursor < len(l.items) {
+			if selected, _ := l.isIndexSelected(l.cursor); !selected {
+				l.Select(l.cursor)
+			}
+			if l.onActivate != nil {
+				l.onActivate(l.cursor, l.items[l.cursor])
+			}
 		}
 		return true
+	case input.KeyRune:
+		if keyEvent.Rune == ' ' && keyEvent.Modifier == input.ModNone && l.cursor < len(l.items) {
+			l.toggle(l.cursor)
+			return true
+		}
+		if prefix := l.typeAhead.key(keyEvent); prefix != "" {
+			if i := l.typeAhead.find(prefix, l.cursor, len(l.items), func(i int) string { return l.items[i].Text }); i >= 0 && i != l.cursor {
+				l.SetCursor(i)
+				l.notifyChange()
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+// HelpEntries returns the list's keys
+func (l *List) HelpEntries() []HelpEntry {
+	return []HelpEntry{
+		{Keys: "↑/↓", Description: "Move"},
+		{Keys: "PgUp/PgDn", Description: "Page"},
+		{Keys: "Home/End", Description: "First/last item"},
+		{Keys: "Space", Description: "Toggle item"},
+		{Keys: "Enter", Description: "Activate item"},
 	}
+}
 
+// Tick ends a type-ahead search once typing has paused
+func (l *List) Tick(now time.Time) bool {
+	l.typeAhead.expire(now)
 	return false
 }
 
+// toggle selects or deselects the item at index and reports it
+func (l *List) toggle(index int) {
+	l.Select(index)
+	if l.onSelect != nil {
+		l.onSelect(index, l.items[index])
+	}
+}
+
 func (l *List) moveUp() {
 	if l.cursor > 0 {
 		l.cursor--
-		l.ensureVisible()
-		l.notifyChange()
+	} else if l.wrap && len(l.items) > 1 {
+		l.cursor = len(l.items) - 1
+	} else {
+		return
 	}
+	l.ensureVisible()
+	l.notifyChange()
 }
 
 func (l *List) moveDown() {
 	if l.cursor < len(l.items)-1 {
 		l.cursor++
-		l.ensureVisible()
-		l.notifyChange()
+	} else if l.wrap && len(l.items) > 1 {
+		l.cursor = 0
+	} else {
+		return
 	}
+	l.ensureVisible()
+	l.notifyChange()
 }
 
 func (l *List) pageUp() {
@@ -365,13 +570,88 @@ func (l *List) pageDown() {
 	l.notifyChange()
 }
 
+// click moves the cursor to and selects the item under a left press, and
+// activates it like Enter on a double-click
+// Returns false if the press isn't over an item
+func (l *List) click(e input.MouseEvent) bool {
+	index := l.itemAt(e.X, e.Y)
+	if index < 0 {
+		return false
+	}
+	double := l.clicks.press(e, index)
+	if index != l.cursor {
+		l.cursor = index
+		l.notifyChange()
+	}
+	if selected, _ := l.isIndexSelected(index); !selected {
+		l.toggle(index)
+	}
+	if double && l.onActivate != nil {
+		l.onActivate(index, l.items[index])
+	}
+	return true
+}
+
+// itemAt returns the index of the item drawn at (x, y), relative to the
+// top-left corner of the last rendered bounds, or -1 if there is none
+func (l *List) itemAt(x, y int) int {
+	if l.showBorder {
+		x--
+		y--
+	}
+	inner := l.bounds.Width
+	if l.showBorder {
+		inner -= 2
+	}
+	if x < 0 || x >= inner || y < 0 || y >= l.viewHeight() {
+		return -1
+	}
+	index := l.offset + y
+	if index >= len(l.items) {
+		return -1
+	}
+	return index
+}
+
+// scrollBy scrolls the view by lines, moving the cursor along with it if
+// the wheel is set to
+func (l *List) scrollBy(lines int) {
+	if l.wheel.moveCursor {
+		l.cursor = max(0, min(l.cursor+lines, len(l.items)-1))
+		l.ensureVisible()
+		l.notifyChange()
+		return
+	}
+	l.offset = clampOffset(l.offset+lines, len(l.items), l.viewHeight())
+	l.notifyScroll()
+}
+
+// viewHeight returns how many items fit in the last rendered bounds, or the
+// configured height before the first render
+func (l *List) viewHeight() int {
+	if l.bounds.IsEmpty() {
+		return max(1, l.height)
+	}
+	height := l.bounds.Height
+	if l.showBorder {
+		height -= 2
+	}
+	return max(1, min(height, l.height))
+}
+
 func (l *List) ensureVisible() {
 	if l.cursor < l.offset {
 		l.offset = l.cursor
 	}
-	if l.cursor >= l.offset+l.height {
-		l.offset = l.cursor - l.height + 1
+	if height := l.viewHeight(); l.cursor >= l.offset+height {
+		l.offset = l.cursor - height + 1
 	}
+	l.notifyScroll()
+}
+
+// notifyScroll reports the offset to the OnScroll callback if it changed
+func (l *List) notifyScroll() {
+	l.scrolled.notify(l.offset, l.viewHeight(), len(l.items))
 }
 
 func (l *List) notifyChange() {
@@ -384,8 +664,8 @@ func (l *List) notifyChange() {
 func (l *List) Size() layout.Size {
 	width := 0
 	for _, item := range l.items {
-		if len(item.Text) > width {
-			width = len(item.Text)
+		if screen.DisplayWidth(item.Text) > width {
+			width = screen.DisplayWidth(item.Text)
 		}
 	}
 	height := len(l.items)
@@ -407,3 +687,15 @@ func (l *List) MinSize() layout.Size {
 	return layout.NewSize(3, 1)
 }
 
+// AccessibleDescription describes the list, the item under the cursor and
+// how many items are selected
+func (l *List) AccessibleDescription() string {
+	desc := fmt.Sprintf("List items=%d", len(l.items))
+	if l.cursor >= 0 && l.cursor < len(l.items) {
+		desc += " current=" + quote(l.items[l.cursor].Text)
+	}
+	if len(l.selected) > 0 {
+		desc += fmt.Sprintf(" selected=%d", len(l.selected))
+	}
+	return desc + focusState(l)
+}
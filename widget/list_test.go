@@ -0,0 +1,105 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// renderWidget renders w into a width by height buffer at the origin
+func renderWidget(w Widget, width, height int) *screen.Buffer {
+	buf := screen.NewBuffer(width, height, screen.DefaultDepth)
+	w.Render(buf, layout.NewRect(0, 0, 0, width, height))
+	return buf
+}
+
+func TestListToString(t *testing.T) {
+	l := NewList().SetStrings([]string{"Alpha", "Beta", "Gamma"})
+	got := renderWidget(l, 8, 4).ToString()
+	want := "Alpha\nBeta\nGamma\n"
+	if got != want {
+		t.Errorf("ToString() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestListHighlightsMatches(t *testing.T) {
+	l := NewList().SetItems([]ListItem{
+		{Text: "日本go", Matches: []int{1, 2}},
+		{Text: "abcdefgh", Matches: []int{0, 5}},
+	}).SetEllipsis(true)
+	buf := renderWidget(l, 6, 2)
+
+	tests := []struct {
+		x, y int
+		want bool
+	}{
+		{0, 0, false}, {1, 0, false}, // 日
+		{2, 0, true}, {3, 0, true}, // 本, both cells
+		{4, 0, true},  // g
+		{5, 0, false}, // o
+		{0, 1, true},  // a
+		{1, 1, false}, // b
+		{5, 1, false}, // The ellipsis replacing f
+	}
+	for _, tt := range tests {
+		if got := buf.Get(tt.x, tt.y, 0).Style == l.matchStyle; got != tt.want {
+			t.Errorf("cell (%d, %d) highlighted = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	// Under the cursor matches are bold and underlined in the cursor style
+	l.SetFocused(true)
+	buf = renderWidget(l, 6, 2)
+	if got, want := buf.Get(2, 0, 0).Style, l.cursorStyle.WithBold().WithUnderline(); got != want {
+		t.Errorf("match under the cursor drawn in %+v, want %+v", got, want)
+	}
+}
+
+func TestListWrapSelection(t *testing.T) {
+	up, down := input.KeyEvent{Key: input.KeyUp}, input.KeyEvent{Key: input.KeyDown}
+	tests := []struct {
+		name   string
+		wrap   bool
+		start  int
+		key    input.KeyEvent
+		cursor int
+		offset int
+	}{
+		{"up at top", false, 0, up, 0, 0},
+		{"down at bottom", false, 9, down, 9, 7},
+		{"wrap up at top", true, 0, up, 9, 7},
+		{"wrap down at bottom", true, 9, down, 0, 0},
+		{"wrap within", true, 4, down, 5, 3},
+	}
+	for _, tt := range tests {
+		l := NewList().SetStrings(numberedStrings(10)).SetWrapSelection(tt.wrap)
+		l.SetFocused(true)
+		renderWidget(l, 5, 3)
+		l.SetCursor(tt.start)
+		l.HandleEvent(tt.key)
+		if l.Cursor() != tt.cursor || l.offset != tt.offset {
+			t.Errorf("%s: cursor, offset = %d, %d, want %d, %d", tt.name, l.Cursor(), l.offset, tt.cursor, tt.offset)
+		}
+	}
+}
+
+func TestListZebra(t *testing.T) {
+	even := terminal.DefaultStyle().WithBG(terminal.ColorBlue)
+	odd := terminal.DefaultStyle().WithBG(terminal.ColorRed)
+	l := NewList().SetStrings(numberedStrings(6)).SetZebra(even, odd)
+	l.SetFocused(true)
+	renderWidget(l, 4, 3)
+	l.SetCursor(3)
+
+	// Rows 1 to 3 are shown; the stripes follow the item index, and the
+	// cursor row keeps the cursor style
+	buf := renderWidget(l, 4, 3)
+	for y, want := range []terminal.Style{odd, even, l.cursorStyle} {
+		if got := buf.Get(0, y, 0).Style; got != want {
+			t.Errorf("row %d style = %+v, want %+v", y, got, want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package widget
+
+import (
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+)
+
+// Loadable wraps a widget that fetches its data asynchronously
+// While loading, the child is drawn dimmed with a centered spinner over it
+// and doesn't receive input
+type Loadable struct {
+	BaseWidget
+	child   Widget
+	spinner *Spinner
+	loading bool
+}
+
+// NewLoadable creates a loading wrapper around child
+func NewLoadable(child Widget) *Loadable {
+	return &Loadable{
+		BaseWidget: NewBaseWidget(),
+		child:      child,
+		spinner:    NewSpinner().SetLabel("Loading..."),
+	}
+}
+
+// SetLoading sets whether the child is loading
+func (l *Loadable) SetLoading(loading bool) *Loadable {
+	l.loading = loading
+	return l
+}
+
+// IsLoading returns whether the child is loading
+func (l *Loadable) IsLoading() bool {
+	return l.loading
+}
+
+// SetLabel sets the text shown next to the spinner
+func (l *Loadable) SetLabel(label string) *Loadable {
+	l.spinner.SetLabel(label)
+	return l
+}
+
+// Spinner returns the spinner shown while loading
+func (l *Loadable) Spinner() *Spinner {
+	return l.spinner
+}
+
+// Child returns the wrapped widget
+func (l *Loadable) Child() Widget {
+	return l.child
+}
+
+// Advance moves the spinner to its next frame while loading
+func (l *Loadable) Advance() {
+	if l.loading {
+		l.spinner.Advance()
+	}
+}
+
+// Tick advances the spinner on the app tick
+// Returns true while loading, since the spinner needs a redraw
+func (l *Loadable) Tick(now time.Time) bool {
+	l.Advance()
+	return l.loading
+}
+
+// Render draws the child, dimmed with the spinner over it while loading
+func (l *Loadable) Render(buf *screen.Buffer, bounds layout.Rect) {
+	l.bounds = bounds
+	if !l.visible || bounds.IsEmpty() {
+		return
+	}
+
+	l.child.Render(buf, bounds)
+	if !l.loading {
+		return
+	}
+
+	for y := bounds.Y; y < bounds.Y+bounds.Height; y++ {
+		for x := bounds.X; x < bounds.X+bounds.Width; x++ {
+			cell := buf.Get(x, y, bounds.Z)
+			buf.SetStyle(x, y, bounds.Z, cell.Style.WithDim())
+		}
+	}
+
+	// Center the spinner with a blank cell of padding on each side
+	size := l.spinner.Size()
+	width := min(size.Width+2, bounds.Width)
+	x := bounds.X + layout.Align(width, bounds.Width, layout.AlignCenter)
+	y := bounds.Y + layout.Align(1, bounds.Height, layout.AlignCenter)
+	buf.FillRect(x, y, bounds.Z, width, 1, screen.NewCell(' ', l.spinner.style))
+	l.spinner.Render(buf, layout.NewRect(x+1, y, bounds.Z, width-2, 1))
+}
+
+// HandleEvent passes events to the child unless it is loading
+func (l *Loadable) HandleEvent(event input.Event) bool {
+	return l.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx passes events to the child along with ctx unless it is
+// loading
+func (l *Loadable) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !l.visible || l.loading {
+		return false
+	}
+	return HandleEventCtx(l.child, ctx, event)
+}
+
+// SetFocused sets the focus state of the wrapper and the child
+func (l *Loadable) SetFocused(focused bool) {
+	l.BaseWidget.SetFocused(focused)
+	l.child.SetFocused(focused)
+}
+
+// IsInteractive returns whether the child can receive input
+func (l *Loadable) IsInteractive() bool {
+	return l.child.IsInteractive()
+}
+
+// Size returns the preferred size of the child
+func (l *Loadable) Size() layout.Size {
+	return l.child.Size()
+}
+
+// MinSize returns the minimum size of the child
+func (l *Loadable) MinSize() layout.Size {
+	return l.child.MinSize()
+}
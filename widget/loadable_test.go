@@ -0,0 +1,36 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestLoadable(t *testing.T) {
+	list := NewList().SetStrings([]string{"first", "second"})
+	loadable := NewLoadable(list).SetLabel("Wait")
+	loadable.SetFocused(true)
+
+	loadable.SetLoading(true)
+	got := renderWidget(loadable, 12, 3).ToString()
+	if !strings.Contains(got, "⠋ Wait") {
+		t.Errorf("rendered %q while loading, want the spinner", got)
+	}
+	loadable.Advance()
+	if got := renderWidget(loadable, 12, 3).ToString(); !strings.Contains(got, "⠙ Wait") {
+		t.Errorf("rendered %q after Advance, want the next spinner frame", got)
+	}
+	if loadable.HandleEvent(input.KeyEvent{Key: input.KeyDown}) || list.Cursor() != 0 {
+		t.Error("child received input while loading")
+	}
+
+	loadable.SetLoading(false)
+	got = renderWidget(loadable, 12, 3).ToString()
+	if got != "first\nsecond\n" {
+		t.Errorf("rendered %q after loading, want only the child", got)
+	}
+	if !loadable.HandleEvent(input.KeyEvent{Key: input.KeyDown}) || list.Cursor() != 1 {
+		t.Error("child didn't receive input after loading")
+	}
+}
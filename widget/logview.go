@@ -0,0 +1,209 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// defaultMaxLogLines is how many lines a LogView keeps by default
+const defaultMaxLogLines = 10000
+
+// LogView shows lines of output as they are appended, such as a log
+// In follow mode, on by default, the view stays at the bottom as lines
+// arrive until the user scrolls up, and follows again once they scroll back
+// to the bottom or ScrollToBottom is called.
+type LogView struct {
+	BaseWidget
+	lines      []string
+	maxLines   int
+	scroll     followScroll
+	viewHeight int // Visible lines from the last render
+	style      terminal.Style
+	showBorder bool
+	wheel      wheelScroll
+}
+
+// NewLogView creates an empty log view in follow mode
+func NewLogView() *LogView {
+	v := &LogView{
+		BaseWidget: NewBaseWidget(),
+		maxLines:   defaultMaxLogLines,
+		style:      terminal.DefaultStyle(),
+		wheel:      newWheelScroll(),
+	}
+	v.scroll.setEnabled(true)
+	v.SetInteractive(true)
+	return v
+}
+
+// Append adds lines at the bottom, dropping the oldest beyond the maximum
+func (v *LogView) Append(lines ...string) *LogView {
+	v.lines = append(v.lines, lines...)
+	if v.maxLines > 0 && len(v.lines) > v.maxLines {
+		dropped := len(v.lines) - v.maxLines
+		v.lines = append(v.lines[:0], v.lines[dropped:]...)
+		if !v.scroll.following() {
+			// Keep the same lines in view
+			v.scroll.offset = max(0, v.scroll.offset-dropped)
+		}
+	}
+	v.scroll.update(len(v.lines), v.visibleLines())
+	return v
+}
+
+// Clear removes all lines
+func (v *LogView) Clear() *LogView {
+	v.lines = nil
+	v.scroll.offset = 0
+	return v
+}
+
+// Lines returns the lines
+func (v *LogView) Lines() []string {
+	return v.lines
+}
+
+// SetMaxLines sets how many lines are kept, 0 for no limit
+func (v *LogView) SetMaxLines(n int) *LogView {
+	v.maxLines = n
+	return v.Append()
+}
+
+// SetFollow turns follow mode on or off; turning it on scrolls to the bottom
+func (v *LogView) SetFollow(follow bool) *LogView {
+	v.scroll.setEnabled(follow)
+	v.scroll.update(len(v.lines), v.visibleLines())
+	return v
+}
+
+// Following returns whether the view is pinned to the bottom, which is
+// false while follow mode is off or paused by scrolling up
+func (v *LogView) Following() bool {
+	return v.scroll.following()
+}
+
+// ScrollToBottom scrolls to the last line, resuming following
+func (v *LogView) ScrollToBottom() *LogView {
+	v.scroll.scrollTo(len(v.lines), len(v.lines), v.visibleLines())
+	return v
+}
+
+// Offset returns the index of the top visible line
+func (v *LogView) Offset() int {
+	return v.scroll.offset
+}
+
+// SetStyle sets the style
+func (v *LogView) SetStyle(style terminal.Style) *LogView {
+	v.style = style
+	return v
+}
+
+// SetShowBorder enables or disables the border
+func (v *LogView) SetShowBorder(show bool) *LogView {
+	v.showBorder = show
+	return v
+}
+
+// SetWheelStep sets how many lines one mouse wheel notch scrolls
+func (v *LogView) SetWheelStep(lines int) *LogView {
+	v.wheel.step = max(1, lines)
+	return v
+}
+
+// visibleLines returns how many lines fit in the last rendered bounds, or
+// all of them before the first render
+func (v *LogView) visibleLines() int {
+	if v.viewHeight <= 0 {
+		return len(v.lines)
+	}
+	return v.viewHeight
+}
+
+// Render draws the visible lines
+func (v *LogView) Render(buf *screen.Buffer, bounds layout.Rect) {
+	v.bounds = bounds
+	if !v.visible || bounds.IsEmpty() {
+		return
+	}
+
+	inner := bounds
+	if v.showBorder {
+		buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, v.style)
+		inner = bounds.Inset(1, 1, 1, 1)
+	}
+	if inner.IsEmpty() {
+		return
+	}
+	if inner.Height != v.viewHeight {
+		v.viewHeight = inner.Height
+		v.scroll.update(len(v.lines), v.viewHeight)
+	}
+
+	for row := 0; row < inner.Height; row++ {
+		i := v.scroll.offset + row
+		if i >= len(v.lines) {
+			break
+		}
+		buf.DrawStringClipped(inner.X, inner.Y+row, inner.Z, screen.Truncate(v.lines[i], inner.Width, false), v.style, inner.Width)
+	}
+}
+
+// HandleEvent handles input events
+func (v *LogView) HandleEvent(event input.Event) bool {
+	if lines := v.wheel.delta(event, v.bounds); lines != 0 {
+		v.scroll.scrollBy(lines, len(v.lines), v.visibleLines())
+		return true
+	}
+	if !v.focused {
+		return false
+	}
+
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok {
+		return false
+	}
+
+	total, visible := len(v.lines), v.visibleLines()
+	switch keyEvent.Key {
+	case input.KeyUp:
+		v.scroll.scrollBy(-1, total, visible)
+	case input.KeyDown:
+		v.scroll.scrollBy(1, total, visible)
+	case input.KeyPageUp:
+		v.scroll.scrollBy(-visible, total, visible)
+	case input.KeyPageDown:
+		v.scroll.scrollBy(visible, total, visible)
+	case input.KeyHome:
+		v.scroll.scrollTo(0, total, visible)
+	case input.KeyEnd:
+		v.ScrollToBottom()
+	default:
+		return false
+	}
+	return true
+}
+
+// Size returns the preferred size
+func (v *LogView) Size() layout.Size {
+	width := 0
+	for _, line := range v.lines {
+		width = max(width, screen.DisplayWidth(line))
+	}
+	height := len(v.lines)
+	if v.showBorder {
+		width += 2
+		height += 2
+	}
+	return layout.NewSize(width, height)
+}
+
+// MinSize returns the minimum size
+func (v *LogView) MinSize() layout.Size {
+	if v.showBorder {
+		return layout.NewSize(3, 3)
+	}
+	return layout.NewSize(1, 1)
+}
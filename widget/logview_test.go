@@ -0,0 +1,73 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestLogViewFollowsAppendedLines(t *testing.T) {
+	v := NewLogView().Append("1", "2", "3", "4", "5")
+	if got := renderWidget(v, 10, 3).ToString(); got != "3\n4\n5" {
+		t.Errorf("render = %q, want %q", got, "3\n4\n5")
+	}
+	v.Append("6")
+	if got := renderWidget(v, 10, 3).ToString(); got != "4\n5\n6" {
+		t.Errorf("render after Append = %q, want %q", got, "4\n5\n6")
+	}
+	if !v.Following() {
+		t.Error("Following() = false, want true")
+	}
+}
+
+func TestLogViewScrollUpPausesFollow(t *testing.T) {
+	v := NewLogView().Append("1", "2", "3", "4", "5")
+	v.SetFocused(true)
+	renderWidget(v, 10, 3)
+
+	v.HandleEvent(input.KeyEvent{Key: input.KeyUp})
+	if v.Following() {
+		t.Error("Following() after scrolling up = true, want false")
+	}
+	v.Append("6")
+	if v.Offset() != 1 {
+		t.Errorf("Offset() after Append while paused = %d, want 1", v.Offset())
+	}
+
+	// Scrolling back down to the bottom resumes following
+	v.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	v.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	if !v.Following() {
+		t.Fatal("Following() at the bottom = false, want true")
+	}
+	v.Append("7")
+	if v.Offset() != 4 {
+		t.Errorf("Offset() after Append = %d, want 4", v.Offset())
+	}
+}
+
+func TestLogViewScrollToBottomResumesFollow(t *testing.T) {
+	v := NewLogView().Append("1", "2", "3", "4", "5")
+	renderWidget(v, 10, 3)
+	v.HandleEvent(wheel(0, 0, false))
+	if v.Following() {
+		t.Fatal("Following() after wheel up = true, want false")
+	}
+	v.ScrollToBottom()
+	if !v.Following() || v.Offset() != 2 {
+		t.Errorf("Following(), Offset() = %v, %d, want true, 2", v.Following(), v.Offset())
+	}
+}
+
+func TestLogViewFollowOff(t *testing.T) {
+	v := NewLogView().SetFollow(false).Append("1", "2", "3")
+	renderWidget(v, 10, 2)
+	v.Append("4", "5")
+	if v.Following() || v.Offset() != 0 {
+		t.Errorf("Following(), Offset() = %v, %d, want false, 0", v.Following(), v.Offset())
+	}
+	v.SetFollow(true)
+	if v.Offset() != 3 {
+		t.Errorf("Offset() after SetFollow(true) = %d, want 3", v.Offset())
+	}
+}
@@ -1,6 +1,9 @@
 package widget
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
@@ -19,14 +22,23 @@ type MenuItem struct {
 // Menu is a menu widget
 type Menu struct {
 	BaseWidget
+	background    background
 	items         []*MenuItem
 	selected      int
+	offset        int // Scroll offset
+	viewHeight    int // Visible rows from the last render
 	style         terminal.Style
 	selectedStyle terminal.Style
 	disabledStyle terminal.Style
 	showBorder    bool
+	ellipsis      bool
+	emptyText     string
+	wheel         wheelScroll
+	scrollbar     *Scrollbar
+	typeAhead     typeAhead
 	width         int
-	onSelect      func(index int, item *MenuItem)
+	onSelect      func(index int, item *MenuItem) // Enter
+	onHighlight   func(index int, item *MenuItem) // Selection moved
 }
 
 // NewMenu creates a new menu widget
@@ -37,6 +49,9 @@ func NewMenu() *Menu {
 		selectedStyle: terminal.DefaultStyle().WithReverse(),
 		disabledStyle: terminal.DefaultStyle().WithDim(),
 		showBorder:    true,
+		wheel:         newWheelScroll(),
+		scrollbar:     NewScrollbar(layout.Vertical),
+		typeAhead:     newTypeAhead(),
 	}
 	m.SetInteractive(true)
 	return m
@@ -52,6 +67,7 @@ func (m *Menu) SetItems(items []*MenuItem) *Menu {
 		m.selected = 0
 	}
 	m.skipDisabled(1)
+	m.ensureVisible()
 	return m
 }
 
@@ -82,6 +98,7 @@ func (m *Menu) Select(index int) *Menu {
 		index = len(m.items) - 1
 	}
 	m.selected = index
+	m.ensureVisible()
 	return m
 }
 
@@ -97,6 +114,18 @@ func (m *Menu) SetShowBorder(show bool) *Menu {
 	return m
 }
 
+// SetEmptyText sets the placeholder shown when the menu has no items
+func (m *Menu) SetEmptyText(text string) *Menu {
+	m.emptyText = text
+	return m
+}
+
+// SetEllipsis enables ending truncated labels with an ellipsis
+func (m *Menu) SetEllipsis(ellipsis bool) *Menu {
+	m.ellipsis = ellipsis
+	return m
+}
+
 // SetStyle sets the normal style
 func (m *Menu) SetStyle(style terminal.Style) *Menu {
 	m.style = style
@@ -115,42 +144,117 @@ func (m *Menu) SetDisabledStyle(style terminal.Style) *Menu {
 	return m
 }
 
-// OnSelect sets the callback for item selection
-func (m *Menu) OnSelect(fn func(index int, item *MenuItem)) *Menu {
+// SetWheelStep sets how many lines one mouse wheel notch scrolls
+func (m *Menu) SetWheelStep(lines int) *Menu {
+	m.wheel.step = max(1, lines)
+	return m
+}
+
+// SetWheelMovesCursor sets whether the mouse wheel moves the selection
+// along with the view, rather than only scrolling it
+func (m *Menu) SetWheelMovesCursor(move bool) *Menu {
+	m.wheel.moveCursor = move
+	return m
+}
+
+// OnActivate sets the callback for Enter on an enabled item, called after
+// the item's Action
+func (m *Menu) OnActivate(fn func(index int, item *MenuItem)) *Menu {
 	m.onSelect = fn
 	return m
 }
 
+// OnSelect is the older name of OnActivate
+// Menus have no multi-selection to toggle, so selecting an item is
+// activating it.
+func (m *Menu) OnSelect(fn func(index int, item *MenuItem)) *Menu {
+	return m.OnActivate(fn)
+}
+
+// SetTypeAhead enables jumping to the next item whose label starts with
+// the letters typed in quick succession, ignoring case and wrapping around
+// Add the menu to the app's tickers so a pause starts a new search even
+// without another key.
+func (m *Menu) SetTypeAhead(enabled bool) *Menu {
+	m.typeAhead.enabled = enabled
+	return m
+}
+
+// OnHighlight sets the callback for the selection moving to another item
+func (m *Menu) OnHighlight(fn func(index int, item *MenuItem)) *Menu {
+	m.onHighlight = fn
+	return m
+}
+
+// SetBackground fills the whole bounds with spaces in style before drawing
+// the content; by default only the rows with content are drawn
+func (m *Menu) SetBackground(style terminal.Style) *Menu {
+	m.background.set(style)
+	return m
+}
+
 // Render draws the menu
 func (m *Menu) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !m.visible {
+	m.bounds = bounds
+	if !m.visible || bounds.IsEmpty() {
 		return
 	}
+	m.background.fill(buf, bounds)
 
 	width := m.calculateWidth()
 	if m.width > 0 {
 		width = m.width
 	}
+	rows := bounds.Height
+	if m.showBorder {
+		rows -= 2
+	}
+	if len(m.items) > rows {
+		width++ // Scrollbar
+	}
 	if width > bounds.Width {
 		width = bounds.Width
 	}
 
-	height := len(m.items)
+	height := m.contentHeight()
 	if m.showBorder {
-		height += 2
-		width += 2
+		height = min(height+2, bounds.Height)
+		width = min(width+2, bounds.Width)
 	}
 
 	innerBounds := bounds
 	if m.showBorder {
 		buf.DrawBox(bounds.X, bounds.Y, bounds.Z, width, height, m.style)
-		innerBounds = layout.NewRect(bounds.X+1, bounds.Y+1, bounds.Z, width-2, len(m.items))
+		innerBounds = layout.NewRect(bounds.X+1, bounds.Y+1, bounds.Z, max(0, width-2), max(0, height-2))
+	}
+	if innerBounds.IsEmpty() {
+		return
 	}
 
-	for i, item := range m.items {
-		if i >= innerBounds.Height {
+	if len(m.items) == 0 {
+		innerBounds.Height = min(innerBounds.Height, 1)
+		drawEmptyText(buf, innerBounds, m.emptyText, m.style)
+		return
+	}
+
+	// Scroll so the selection stays visible if the viewport changed,
+	// reserving a column for the scrollbar when the items overflow
+	if innerBounds.Height != m.viewHeight {
+		m.viewHeight = innerBounds.Height
+		m.ensureVisible()
+	}
+	overflow := len(m.items) > innerBounds.Height
+	itemBounds := innerBounds
+	if overflow {
+		itemBounds.Width--
+	}
+
+	for row := 0; row < innerBounds.Height; row++ {
+		i := m.offset + row
+		if i >= len(m.items) {
 			break
 		}
+		item := m.items[i]
 
 		style := m.style
 		if item.Disabled {
@@ -160,36 +264,50 @@ func (m *Menu) Render(buf *screen.Buffer, bounds layout.Rect) {
 		}
 
 		// Clear line
-		for x := 0; x < innerBounds.Width; x++ {
-			buf.Set(innerBounds.X+x, innerBounds.Y+i, innerBounds.Z, screen.NewCell(' ', style))
+		for x := 0; x < itemBounds.Width; x++ {
+			buf.Set(itemBounds.X+x, innerBounds.Y+row, innerBounds.Z, screen.NewCell(' ', style))
 		}
 
 		// Draw label
-		label := item.Label
-		if len(label) > innerBounds.Width {
-			label = label[:innerBounds.Width]
-		}
-		buf.DrawString(innerBounds.X, innerBounds.Y+i, innerBounds.Z, label, style)
+		label := screen.Truncate(item.Label, itemBounds.Width, m.ellipsis)
+		buf.DrawString(itemBounds.X, innerBounds.Y+row, innerBounds.Z, label, style)
 
 		// Draw shortcut if present
-		if item.Shortcut != "" && innerBounds.Width > len(label)+len(item.Shortcut)+2 {
-			shortcutX := innerBounds.X + innerBounds.Width - len(item.Shortcut)
-			buf.DrawString(shortcutX, innerBounds.Y+i, innerBounds.Z, item.Shortcut, style.WithDim())
+		if item.Shortcut != "" && itemBounds.Width > screen.DisplayWidth(label)+screen.DisplayWidth(item.Shortcut)+2 {
+			shortcutX := itemBounds.X + itemBounds.Width - screen.DisplayWidth(item.Shortcut)
+			buf.DrawString(shortcutX, innerBounds.Y+row, innerBounds.Z, item.Shortcut, style.WithDim())
 		}
 
 		// Draw submenu indicator
 		if len(item.Children) > 0 {
-			buf.Set(innerBounds.X+innerBounds.Width-1, innerBounds.Y+i, innerBounds.Z, screen.NewCell('▶', style))
+			buf.Set(itemBounds.X+itemBounds.Width-1, innerBounds.Y+row, innerBounds.Z, screen.NewCell('▶', style))
 		}
 	}
+
+	if overflow {
+		scrollBounds := layout.NewRect(innerBounds.X+innerBounds.Width-1, innerBounds.Y, innerBounds.Z, 1, innerBounds.Height)
+		m.scrollbar.Render(buf, scrollBounds, len(m.items), innerBounds.Height, m.offset)
+	}
+}
+
+// contentHeight returns the number of lines inside the border,
+// reserving one for the placeholder when the menu is empty
+func (m *Menu) contentHeight() int {
+	if len(m.items) == 0 && m.emptyText != "" {
+		return 1
+	}
+	return len(m.items)
 }
 
 func (m *Menu) calculateWidth() int {
+	if len(m.items) == 0 {
+		return screen.DisplayWidth(m.emptyText)
+	}
 	width := 0
 	for _, item := range m.items {
-		itemWidth := len(item.Label)
+		itemWidth := screen.DisplayWidth(item.Label)
 		if item.Shortcut != "" {
-			itemWidth += 2 + len(item.Shortcut)
+			itemWidth += 2 + screen.DisplayWidth(item.Shortcut)
 		}
 		if len(item.Children) > 0 {
 			itemWidth += 2
@@ -203,6 +321,10 @@ func (m *Menu) calculateWidth() int {
 
 // HandleEvent handles input events
 func (m *Menu) HandleEvent(event input.Event) bool {
+	if lines := m.wheel.delta(event, m.bounds); lines != 0 {
+		m.scrollBy(lines)
+		return true
+	}
 	if !m.focused {
 		return false
 	}
@@ -224,25 +346,70 @@ func (m *Menu) HandleEvent(event input.Event) bool {
 		return true
 	case input.KeyEscape:
 		return true
+	case input.KeyRune:
+		if prefix := m.typeAhead.key(keyEvent); prefix != "" {
+			m.jumpTo(prefix)
+			return true
+		}
 	}
 
 	return false
 }
 
 func (m *Menu) moveUp() {
+	defer m.notifyHighlight(m.selected)
 	m.selected--
 	if m.selected < 0 {
 		m.selected = len(m.items) - 1
 	}
 	m.skipDisabled(-1)
+	m.ensureVisible()
 }
 
 func (m *Menu) moveDown() {
+	defer m.notifyHighlight(m.selected)
 	m.selected++
 	if m.selected >= len(m.items) {
 		m.selected = 0
 	}
 	m.skipDisabled(1)
+	m.ensureVisible()
+}
+
+// scrollBy scrolls the view by lines, moving the selection along with it
+// if the wheel is set to
+func (m *Menu) scrollBy(lines int) {
+	if !m.wheel.moveCursor {
+		height := m.viewHeight
+		if height <= 0 {
+			height = len(m.items)
+		}
+		m.offset = clampOffset(m.offset+lines, len(m.items), height)
+		return
+	}
+	defer m.notifyHighlight(m.selected)
+	m.selected = max(0, min(m.selected+lines, len(m.items)-1))
+	if lines < 0 {
+		m.skipDisabled(-1)
+	} else {
+		m.skipDisabled(1)
+	}
+	m.ensureVisible()
+}
+
+// ensureVisible scrolls so the selected item is within the visible rows
+func (m *Menu) ensureVisible() {
+	height := m.viewHeight
+	if height <= 0 {
+		height = len(m.items)
+	}
+	if m.selected < m.offset {
+		m.offset = m.selected
+	}
+	if m.selected >= m.offset+height {
+		m.offset = m.selected - height + 1
+	}
+	m.offset = max(0, min(m.offset, len(m.items)-height))
 }
 
 func (m *Menu) skipDisabled(direction int) {
@@ -261,6 +428,36 @@ func (m *Menu) skipDisabled(direction int) {
 	}
 }
 
+// jumpTo selects the next enabled item whose label starts with prefix
+func (m *Menu) jumpTo(prefix string) {
+	i := m.typeAhead.find(prefix, m.selected, len(m.items), func(i int) string {
+		if m.items[i].Disabled {
+			return ""
+		}
+		return m.items[i].Label
+	})
+	if i < 0 || i == m.selected {
+		return
+	}
+	prev := m.selected
+	m.selected = i
+	m.ensureVisible()
+	m.notifyHighlight(prev)
+}
+
+// Tick ends a type-ahead search once typing has paused
+func (m *Menu) Tick(now time.Time) bool {
+	m.typeAhead.expire(now)
+	return false
+}
+
+// notifyHighlight reports the selected item if it is no longer prev
+func (m *Menu) notifyHighlight(prev int) {
+	if m.onHighlight != nil && m.selected != prev && m.selected >= 0 && m.selected < len(m.items) {
+		m.onHighlight(m.selected, m.items[m.selected])
+	}
+}
+
 func (m *Menu) activate() {
 	if m.selected < 0 || m.selected >= len(m.items) {
 		return
@@ -286,7 +483,7 @@ func (m *Menu) Size() layout.Size {
 	if m.width > 0 {
 		width = m.width
 	}
-	height := len(m.items)
+	height := m.contentHeight()
 	if m.showBorder {
 		width += 2
 		height += 2
@@ -310,3 +507,14 @@ func Separator() *MenuItem {
 	}
 }
 
+// AccessibleDescription describes the menu and its highlighted item
+func (m *Menu) AccessibleDescription() string {
+	desc := fmt.Sprintf("Menu items=%d", len(m.items))
+	if item := m.SelectedItem(); item != nil {
+		desc += " current=" + quote(item.Label)
+		if item.Disabled {
+			desc += " [disabled]"
+		}
+	}
+	return desc + focusState(m)
+}
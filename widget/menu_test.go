@@ -0,0 +1,50 @@
+package widget
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// numberedMenu returns a borderless menu of n items "Item 0", "Item 1", ...
+func numberedMenu(n int) *Menu {
+	items := make([]*MenuItem, n)
+	for i := range items {
+		items[i] = &MenuItem{Label: "Item " + strconv.Itoa(i)}
+	}
+	m := NewMenu().SetShowBorder(false).SetItems(items)
+	m.SetFocused(true)
+	return m
+}
+
+func TestMenuScrollsToSelection(t *testing.T) {
+	m := numberedMenu(30)
+	renderWidget(m, 12, 10)
+
+	for i := 0; i < 15; i++ {
+		m.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	}
+	if m.Selected() != 15 || m.offset != 6 {
+		t.Errorf("selected %d at offset %d, want 15 at offset 6", m.Selected(), m.offset)
+	}
+	lines := strings.Split(renderWidget(m, 12, 10).ToString(), "\n")
+	if !strings.HasPrefix(lines[0], "Item 6") || !strings.HasPrefix(lines[9], "Item 15") {
+		t.Errorf("rendered rows %q to %q, want Item 6 to Item 15", lines[0], lines[9])
+	}
+
+	for i := 0; i < 10; i++ {
+		m.HandleEvent(input.KeyEvent{Key: input.KeyUp})
+	}
+	if m.Selected() != 5 || m.offset != 5 {
+		t.Errorf("selected %d at offset %d, want 5 at offset 5", m.Selected(), m.offset)
+	}
+
+	// Wrapping to the last item scrolls to the end
+	m.Select(0)
+	m.HandleEvent(input.KeyEvent{Key: input.KeyUp})
+	if m.Selected() != 29 || m.offset != 20 {
+		t.Errorf("selected %d at offset %d after wrapping, want 29 at offset 20", m.Selected(), m.offset)
+	}
+}
@@ -0,0 +1,245 @@
+package widget
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+	"github.com/agiles231/gotui/text"
+)
+
+// paletteRows is how many commands a CommandPalette shows at once
+const paletteRows = 10
+
+// Command is an action offered by a CommandPalette
+type Command struct {
+	Name     string
+	Shortcut string // Shown next to the name, e.g. "Ctrl+S"
+	Run      func()
+}
+
+// CommandPalette is a searchable list of commands
+// Typing filters the commands by fuzzy matching their names, best match
+// first; Up/Down move through them, Enter runs one and Escape dismisses
+// the palette
+type CommandPalette struct {
+	BaseWidget
+	input     *TextInput
+	list      *List
+	commands  []Command
+	matches   []Command
+	positions [][]int // Matched rune indexes of each match's name
+	query     string
+	itemWidth int // Width the list items were formatted for
+	width     int
+	style     terminal.Style
+	onRun     func(cmd Command)
+	onClose   func()
+}
+
+// NewCommandPalette creates an empty command palette
+func NewCommandPalette() *CommandPalette {
+	p := &CommandPalette{
+		BaseWidget: NewBaseWidget(),
+		input:      NewTextInput().SetPlaceholder("Type a command"),
+		list:       NewList().SetHeight(paletteRows).SetEmptyText("No matching commands"),
+		width:      50,
+		style:      terminal.DefaultStyle(),
+	}
+	p.list.OnActivate(func(int, ListItem) { p.run() }) // Double-click
+	p.SetInteractive(true)
+	return p
+}
+
+// SetCommands sets the commands offered
+func (p *CommandPalette) SetCommands(commands []Command) *CommandPalette {
+	p.commands = commands
+	p.filter()
+	return p
+}
+
+// Commands returns the commands offered
+func (p *CommandPalette) Commands() []Command {
+	return p.commands
+}
+
+// Matches returns the commands matching the query, best match first
+func (p *CommandPalette) Matches() []Command {
+	return p.matches
+}
+
+// SetQuery sets the search text and filters the commands
+func (p *CommandPalette) SetQuery(query string) *CommandPalette {
+	p.input.SetValue(query)
+	p.filter()
+	return p
+}
+
+// Query returns the search text
+func (p *CommandPalette) Query() string {
+	return p.input.Value()
+}
+
+// SetWidth sets the preferred width
+func (p *CommandPalette) SetWidth(width int) *CommandPalette {
+	p.width = width
+	return p
+}
+
+// SetStyle sets the style
+func (p *CommandPalette) SetStyle(style terminal.Style) *CommandPalette {
+	p.style = style
+	p.list.SetStyle(style)
+	return p
+}
+
+// OnRun sets the callback for a command being chosen, called before the
+// command runs
+func (p *CommandPalette) OnRun(fn func(cmd Command)) *CommandPalette {
+	p.onRun = fn
+	return p
+}
+
+// OnClose sets the callback for the palette being dismissed with Escape
+func (p *CommandPalette) OnClose(fn func()) *CommandPalette {
+	p.onClose = fn
+	return p
+}
+
+// filter matches the commands against the query and moves the cursor back
+// to the best match
+func (p *CommandPalette) filter() {
+	p.query = p.input.Value()
+	type scored struct {
+		cmd       Command
+		score     int
+		positions []int
+	}
+	var found []scored
+	for _, cmd := range p.commands {
+		if score, positions, ok := text.FuzzyMatch(p.query, cmd.Name); ok {
+			found = append(found, scored{cmd, score, positions})
+		}
+	}
+	slices.SortStableFunc(found, func(a, b scored) int {
+		return b.score - a.score
+	})
+	p.matches = make([]Command, len(found))
+	p.positions = make([][]int, len(found))
+	for i, f := range found {
+		p.matches[i] = f.cmd
+		p.positions[i] = f.positions
+	}
+	p.setItems()
+	p.list.SetCursor(0)
+}
+
+// setItems fills the list with the matches, shortcuts right-aligned to
+// itemWidth
+func (p *CommandPalette) setItems() {
+	items := make([]ListItem, len(p.matches))
+	for i, cmd := range p.matches {
+		label := cmd.Name
+		if cmd.Shortcut != "" {
+			gap := p.itemWidth - screen.DisplayWidth(cmd.Name) - screen.DisplayWidth(cmd.Shortcut)
+			label += strings.Repeat(" ", max(2, gap)) + cmd.Shortcut
+		}
+		items[i] = ListItem{Text: label, Value: i, Matches: p.positions[i]}
+	}
+	p.list.SetItems(items)
+}
+
+// SetFocused sets focus state, passing it on to the input and list
+func (p *CommandPalette) SetFocused(focused bool) {
+	p.BaseWidget.SetFocused(focused)
+	p.input.SetFocused(focused)
+	p.list.SetFocused(focused)
+}
+
+// Children returns the input and the list
+func (p *CommandPalette) Children() []Widget {
+	return []Widget{p.input, p.list}
+}
+
+// Render draws the palette
+func (p *CommandPalette) Render(buf *screen.Buffer, bounds layout.Rect) {
+	p.bounds = bounds
+	if !p.visible || bounds.Width < 4 || bounds.Height < 4 {
+		return
+	}
+
+	buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, p.style)
+	inner := bounds.Inset(1, 1, 1, 1)
+
+	buf.DrawString(inner.X, inner.Y, inner.Z, "> ", p.style.WithBold())
+	p.input.Render(buf, layout.NewRect(inner.X+2, inner.Y, inner.Z, inner.Width-2, 1))
+	buf.DrawHLine(inner.X, inner.Y+1, inner.Z, inner.Width, screen.DefaultBorders().Horizontal, p.style.WithDim())
+
+	listBounds := inner.Inset(2, 0, 0, 0)
+	if width := listBounds.Width - 1; width != p.itemWidth {
+		// Leave a column for the scrollbar
+		p.itemWidth = width
+		cursor := p.list.Cursor()
+		p.setItems()
+		p.list.SetCursor(cursor)
+	}
+	p.list.Render(buf, listBounds)
+}
+
+// HandleEvent handles input events
+func (p *CommandPalette) HandleEvent(event input.Event) bool {
+	if !p.focused {
+		return false
+	}
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok {
+		return p.list.HandleEvent(event)
+	}
+
+	switch keyEvent.Key {
+	case input.KeyEscape:
+		if p.onClose != nil {
+			p.onClose()
+		}
+		return true
+	case input.KeyEnter:
+		p.run()
+		return true
+	case input.KeyUp, input.KeyDown, input.KeyPageUp, input.KeyPageDown:
+		return p.list.HandleEvent(event)
+	}
+
+	handled := p.input.HandleEvent(event)
+	if p.input.Value() != p.query {
+		p.filter()
+	}
+	return handled
+}
+
+// run runs the command under the cursor
+func (p *CommandPalette) run() {
+	cursor := p.list.Cursor()
+	if cursor < 0 || cursor >= len(p.matches) {
+		return
+	}
+	cmd := p.matches[cursor]
+	if p.onRun != nil {
+		p.onRun(cmd)
+	}
+	if cmd.Run != nil {
+		cmd.Run()
+	}
+}
+
+// Size returns the preferred size
+func (p *CommandPalette) Size() layout.Size {
+	return layout.NewSize(p.width, paletteRows+4)
+}
+
+// MinSize returns the minimum size
+func (p *CommandPalette) MinSize() layout.Size {
+	return layout.NewSize(20, 5)
+}
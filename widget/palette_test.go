@@ -0,0 +1,71 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestCommandPaletteFiltersByFuzzyScore(t *testing.T) {
+	var ran string
+	cmd := func(name string) Command {
+		return Command{Name: name, Run: func() { ran = name }}
+	}
+	p := NewCommandPalette().SetCommands([]Command{
+		cmd("Reset Layout View"),
+		cmd("Reload"),
+		cmd("Save File"),
+	})
+	p.SetFocused(true)
+	if len(p.Matches()) != 3 {
+		t.Fatalf("empty query matched %d commands, want all 3", len(p.Matches()))
+	}
+
+	typeText(p, "save")
+	var names []string
+	for _, m := range p.Matches() {
+		names = append(names, m.Name)
+	}
+	if len(names) != 2 || names[0] != "Save File" || names[1] != "Reset Layout View" {
+		t.Fatalf("matches = %q, want the prefix match before the scattered one", names)
+	}
+
+	p.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if ran != "Save File" {
+		t.Errorf("Enter ran %q, want the best match", ran)
+	}
+	p.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	p.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if ran != "Reset Layout View" {
+		t.Errorf("Down, Enter ran %q, want the second match", ran)
+	}
+
+	typeText(p, "x")
+	if len(p.Matches()) != 0 {
+		t.Errorf("query %q matched %d commands, want none", p.Query(), len(p.Matches()))
+	}
+	ran = ""
+	p.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if ran != "" {
+		t.Errorf("Enter with no matches ran %q", ran)
+	}
+}
+
+func TestCommandPaletteRunAndClose(t *testing.T) {
+	var chosen []string
+	closed := false
+	p := NewCommandPalette().
+		SetCommands([]Command{{Name: "Quit"}}).
+		OnRun(func(cmd Command) { chosen = append(chosen, cmd.Name) }).
+		OnClose(func() { closed = true })
+	p.SetFocused(true)
+
+	p.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if len(chosen) != 1 || chosen[0] != "Quit" {
+		t.Errorf("OnRun got %v, want [Quit]", chosen)
+	}
+	p.HandleEvent(input.KeyEvent{Key: input.KeyEscape})
+	if !closed {
+		t.Error("Escape did not close the palette")
+	}
+}
@@ -21,8 +21,15 @@ type Progress struct {
 	fillChar    rune
 	emptyChar   rune
 	label       string
+	gradient    bool
+	gradFrom    terminal.RGB
+	gradTo      terminal.RGB
+	smooth      bool
 }
 
+// partialBlocks are the left-aligned eighth-block glyphs for 1/8 to 7/8 of a cell
+var partialBlocks = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉'}
+
 // NewProgress creates a new progress bar
 func NewProgress() *Progress {
 	return &Progress{
@@ -99,6 +106,28 @@ func (p *Progress) SetFillStyle(style terminal.Style) *Progress {
 	return p
 }
 
+// SetGradient colors the filled cells by interpolating from one color at
+// the start of the bar to another at the end, overriding the fill style color
+func (p *Progress) SetGradient(from, to terminal.RGB) *Progress {
+	p.gradient = true
+	p.gradFrom = from
+	p.gradTo = to
+	return p
+}
+
+// ClearGradient returns to the solid fill style
+func (p *Progress) ClearGradient() *Progress {
+	p.gradient = false
+	return p
+}
+
+// SetSmooth enables rendering the fractional part of the last filled cell
+// with eighth-block glyphs. Not all fonts include these glyphs.
+func (p *Progress) SetSmooth(smooth bool) *Progress {
+	p.smooth = smooth
+	return p
+}
+
 // SetChars sets the fill and empty characters
 func (p *Progress) SetChars(fill, empty rune) *Progress {
 	p.fillChar = fill
@@ -108,7 +137,8 @@ func (p *Progress) SetChars(fill, empty rune) *Progress {
 
 // Render draws the progress bar
 func (p *Progress) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !p.visible {
+	p.bounds = bounds
+	if !p.visible || bounds.IsEmpty() {
 		return
 	}
 
@@ -118,12 +148,13 @@ func (p *Progress) Render(buf *screen.Buffer, bounds layout.Rect) {
 	if width > bounds.Width {
 		width = bounds.Width
 	}
+	right := bounds.X + width
 
 	// Draw label if present
 	if p.label != "" {
-		buf.DrawString(x, y, bounds.Z, p.label+": ", p.style)
-		x += len(p.label) + 2
-		width -= len(p.label) + 2
+		buf.DrawStringClipped(x, y, bounds.Z, p.label+": ", p.style, width)
+		x += screen.DisplayWidth(p.label) + 2
+		width -= screen.DisplayWidth(p.label) + 2
 	}
 
 	// Reserve space for percentage
@@ -132,15 +163,23 @@ func (p *Progress) Render(buf *screen.Buffer, bounds layout.Rect) {
 		percentText = fmt.Sprintf(" %3d%%", p.Percent())
 		width -= len(percentText)
 	}
+	width = max(0, width)
 
 	// Calculate filled portion
-	filled := int(float64(width) * p.value)
+	exact := float64(width) * p.value
+	filled := int(exact)
+	partial := -1
+	if p.smooth {
+		partial = partialBlockIndex(exact - float64(filled))
+	}
 
 	// Draw progress bar
 	for i := 0; i < width; i++ {
 		var cell screen.Cell
 		if i < filled {
-			cell = screen.NewCell(p.fillChar, p.fillStyle)
+			cell = screen.NewCell(p.fillChar, p.cellFillStyle(i, width))
+		} else if i == filled && partial >= 0 {
+			cell = screen.NewCell(partialBlocks[partial], p.cellFillStyle(i, width))
 		} else {
 			cell = screen.NewCell(p.emptyChar, p.style)
 		}
@@ -149,10 +188,35 @@ func (p *Progress) Render(buf *screen.Buffer, bounds layout.Rect) {
 
 	// Draw percentage
 	if p.showPercent {
-		buf.DrawString(x+width, y, bounds.Z, percentText, p.style)
+		buf.DrawStringClipped(x+width, y, bounds.Z, percentText, p.style, right-x-width)
 	}
 }
 
+// partialBlockIndex maps the fractional part of a cell to an index into
+// partialBlocks, or -1 when the fraction is less than an eighth
+func partialBlockIndex(fraction float64) int {
+	eighths := int(fraction * 8)
+	if eighths <= 0 {
+		return -1
+	}
+	if eighths > len(partialBlocks) {
+		eighths = len(partialBlocks)
+	}
+	return eighths - 1
+}
+
+// cellFillStyle returns the fill style for cell i of a bar width cells wide
+func (p *Progress) cellFillStyle(i, width int) terminal.Style {
+	if !p.gradient {
+		return p.fillStyle
+	}
+	t := 0.0
+	if width > 1 {
+		t = float64(i) / float64(width-1)
+	}
+	return p.fillStyle.WithFG(p.gradFrom.Lerp(p.gradTo, t))
+}
+
 // HandleEvent handles input events (progress bars don't handle input)
 func (p *Progress) HandleEvent(event input.Event) bool {
 	return false
@@ -162,7 +226,7 @@ func (p *Progress) HandleEvent(event input.Event) bool {
 func (p *Progress) Size() layout.Size {
 	width := p.width
 	if p.label != "" {
-		width += len(p.label) + 2
+		width += screen.DisplayWidth(p.label) + 2
 	}
 	if p.showPercent {
 		width += 5
@@ -218,7 +282,8 @@ func (s *Spinner) Advance() {
 
 // Render draws the spinner
 func (s *Spinner) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !s.visible || len(s.frames) == 0 {
+	s.bounds = bounds
+	if !s.visible || bounds.IsEmpty() || len(s.frames) == 0 {
 		return
 	}
 
@@ -248,3 +313,11 @@ func (s *Spinner) MinSize() layout.Size {
 	return layout.NewSize(1, 1)
 }
 
+// AccessibleDescription describes the bar by its label and percentage
+func (p *Progress) AccessibleDescription() string {
+	desc := "Progress"
+	if p.label != "" {
+		desc += " " + quote(p.label)
+	}
+	return fmt.Sprintf("%s value=%d%%", desc, p.Percent())
+}
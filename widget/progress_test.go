@@ -0,0 +1,44 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestProgressGradient(t *testing.T) {
+	p := NewProgress().SetWidth(5).SetShowPercent(false).SetValue(1).
+		SetGradient(terminal.NewRGB(0, 0, 0), terminal.NewRGB(200, 100, 0))
+	buf := renderWidget(p, 5, 1)
+
+	for x, want := range map[int]terminal.RGB{
+		0: terminal.NewRGB(0, 0, 0),
+		2: terminal.NewRGB(100, 50, 0),
+		4: terminal.NewRGB(200, 100, 0),
+	} {
+		if got := buf.Get(x, 0, 0).Style.FG; got != want {
+			t.Errorf("cell %d FG = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestProgressSmoothPartialGlyph(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{0, "░░░░"},
+		{0.03, "░░░░"}, // Less than an eighth of a cell
+		{0.25, "█░░░"},
+		{0.3125, "█▎░░"},
+		{0.40625, "█▋░░"},
+		{0.49, "█▉░░"},
+		{1, "████"},
+	}
+	for _, tt := range tests {
+		p := NewProgress().SetWidth(4).SetShowPercent(false).SetSmooth(true).SetValue(tt.value)
+		if got := renderWidget(p, 4, 1).ToString(); got != tt.want {
+			t.Errorf("value %v rendered %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
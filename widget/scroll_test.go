@@ -0,0 +1,74 @@
+package widget
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// scrollLog records OnScroll calls as "offset/visible/total"
+type scrollLog []string
+
+func (s *scrollLog) record(offset, visible, total int) {
+	*s = append(*s, fmt.Sprintf("%d/%d/%d", offset, visible, total))
+}
+
+func TestListOnScroll(t *testing.T) {
+	var got scrollLog
+	l := NewList().SetStrings(numberedStrings(10)).OnScroll(got.record)
+	l.SetFocused(true)
+	renderWidget(l, 5, 3)
+
+	down := input.KeyEvent{Key: input.KeyDown}
+	l.HandleEvent(down)
+	l.HandleEvent(down) // Cursor at the last visible row, no scroll yet
+	if len(got) != 0 {
+		t.Fatalf("OnScroll called without scrolling: %v", got)
+	}
+	l.HandleEvent(down)
+	l.HandleEvent(input.KeyEvent{Key: input.KeyPageDown})
+	l.HandleEvent(input.KeyEvent{Key: input.KeyUp}) // Still in view
+	l.HandleEvent(wheel(0, 0, false))
+	want := scrollLog{"1/3/10", "7/3/10", "4/3/10"}
+	if !slices.Equal(got, want) {
+		t.Errorf("OnScroll got %v, want %v", got, want)
+	}
+}
+
+func TestTableOnScroll(t *testing.T) {
+	var got scrollLog
+	table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(10)).OnScroll(got.record)
+	table.SetFocused(true)
+	renderWidget(table, 5, 3)
+
+	table.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	table.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	if len(got) != 0 {
+		t.Fatalf("OnScroll called without scrolling: %v", got)
+	}
+	table.HandleEvent(input.KeyEvent{Key: input.KeyPageDown})
+	table.HandleEvent(input.KeyEvent{Key: input.KeyPageDown})
+	table.HandleEvent(wheel(0, 0, false))
+	want := scrollLog{"3/3/10", "6/3/10", "3/3/10"}
+	if !slices.Equal(got, want) {
+		t.Errorf("OnScroll got %v, want %v", got, want)
+	}
+}
+
+func TestScrollViewOnScroll(t *testing.T) {
+	var got scrollLog
+	view := NewScrollView(NewText(strings.Repeat("line\n", 9) + "line")).OnScroll(got.record)
+	view.SetFocused(true)
+	renderWidget(view, 6, 3)
+
+	view.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	view.HandleEvent(input.KeyEvent{Key: input.KeyEnd})
+	view.HandleEvent(input.KeyEvent{Key: input.KeyEnd}) // Already at the end
+	want := scrollLog{"1/3/10", "7/3/10"}
+	if !slices.Equal(got, want) {
+		t.Errorf("OnScroll got %v, want %v", got, want)
+	}
+}
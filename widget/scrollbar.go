@@ -0,0 +1,167 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// Scrollbar draws how far a scrolled view is through its content as a
+// thumb on a track, and turns mouse drags on it into scroll offsets
+// It is drawn by the widget it scrolls rather than laid out on its own:
+// the widget renders it into a column (Vertical) or row (Horizontal) of
+// its bounds and passes mouse events to Drag.
+type Scrollbar struct {
+	direction  layout.Direction
+	track      rune
+	thumb      rune
+	trackStyle terminal.Style
+	thumbStyle terminal.Style
+	bounds     layout.Rect // Where it was last drawn
+	total      int
+	visible    int
+	offset     int
+	dragging   bool
+	grab       int // Cell of the thumb held while dragging
+}
+
+// NewScrollbar creates a scrollbar running in direction
+func NewScrollbar(direction layout.Direction) *Scrollbar {
+	s := &Scrollbar{
+		direction:  direction,
+		track:      '│',
+		thumb:      '█',
+		trackStyle: terminal.DefaultStyle().WithDim(),
+		thumbStyle: terminal.DefaultStyle().WithReverse(),
+	}
+	if direction == layout.Horizontal {
+		s.track = '─'
+	}
+	return s
+}
+
+// SetChars sets the track and thumb characters
+func (s *Scrollbar) SetChars(track, thumb rune) *Scrollbar {
+	s.track = track
+	s.thumb = thumb
+	return s
+}
+
+// SetStyles sets the track and thumb styles
+func (s *Scrollbar) SetStyles(track, thumb terminal.Style) *Scrollbar {
+	s.trackStyle = track
+	s.thumbStyle = thumb
+	return s
+}
+
+// Dragging returns true while the thumb is being dragged
+func (s *Scrollbar) Dragging() bool {
+	return s.dragging
+}
+
+// ScrollThumb returns the position and size of the thumb on a track of
+// length cells, for a view showing visible of total lines from offset
+// The size is 0 when everything fits and no scrollbar is needed.
+func ScrollThumb(length, total, visible, offset int) (pos, size int) {
+	if length <= 0 || visible <= 0 || total <= visible {
+		return 0, 0
+	}
+	size = max(1, min(length, length*visible/total))
+	pos = offset * (length - size) / (total - visible)
+	return max(0, min(pos, length-size)), size
+}
+
+// length returns the track length within bounds
+func (s *Scrollbar) length(bounds layout.Rect) int {
+	if s.direction == layout.Horizontal {
+		return bounds.Width
+	}
+	return bounds.Height
+}
+
+// Render draws the scrollbar along the first column (Vertical) or row
+// (Horizontal) of bounds, for a view showing visible of total lines from
+// offset
+// Nothing is drawn when everything fits.
+func (s *Scrollbar) Render(buf *screen.Buffer, bounds layout.Rect, total, visible, offset int) {
+	s.bounds = bounds
+	s.total, s.visible, s.offset = total, visible, offset
+	length := s.length(bounds)
+	pos, size := ScrollThumb(length, total, visible, offset)
+	if size == 0 {
+		return
+	}
+
+	track, thumb := s.track, s.thumb
+	if screen.AsciiOnly() {
+		track, thumb = '|', '#'
+		if s.direction == layout.Horizontal {
+			track = '-'
+		}
+	}
+	for i := 0; i < length; i++ {
+		cell := screen.NewCell(track, s.trackStyle)
+		if i >= pos && i < pos+size {
+			cell = screen.NewCell(thumb, s.thumbStyle)
+		}
+		if s.direction == layout.Horizontal {
+			buf.Set(bounds.X+i, bounds.Y, bounds.Z, cell)
+		} else {
+			buf.Set(bounds.X, bounds.Y+i, bounds.Z, cell)
+		}
+	}
+}
+
+// Drag handles a mouse event relative to the top-left corner of parent,
+// the bounds of the widget that drew the scrollbar
+// A left press on the thumb grabs it and one on the track centers the
+// thumb there; the thumb then follows the pointer until release. Returns
+// the new offset and true if the event was used.
+func (s *Scrollbar) Drag(e input.MouseEvent, parent layout.Rect) (int, bool) {
+	x, y := parent.X+e.X, parent.Y+e.Y
+	along := y - s.bounds.Y
+	if s.direction == layout.Horizontal {
+		along = x - s.bounds.X
+	}
+
+	if s.dragging {
+		if e.Button == input.MouseRelease {
+			s.dragging = false
+		} else {
+			s.offset = s.offsetAt(along - s.grab)
+		}
+		return s.offset, true
+	}
+
+	onBar := s.bounds.Width > 0 && s.bounds.Height > 0 && x >= s.bounds.X && y >= s.bounds.Y
+	if s.direction == layout.Horizontal {
+		onBar = onBar && y == s.bounds.Y && x < s.bounds.X+s.bounds.Width
+	} else {
+		onBar = onBar && x == s.bounds.X && y < s.bounds.Y+s.bounds.Height
+	}
+	pos, size := ScrollThumb(s.length(s.bounds), s.total, s.visible, s.offset)
+	if e.Button != input.MouseLeft || e.Motion || !onBar || size == 0 {
+		return s.offset, false
+	}
+
+	s.dragging = true
+	if along >= pos && along < pos+size {
+		s.grab = along - pos
+		return s.offset, true
+	}
+	s.grab = size / 2
+	s.offset = s.offsetAt(along - s.grab)
+	return s.offset, true
+}
+
+// offsetAt returns the offset that puts the thumb at pos
+func (s *Scrollbar) offsetAt(pos int) int {
+	_, size := ScrollThumb(s.length(s.bounds), s.total, s.visible, s.offset)
+	span := s.length(s.bounds) - size
+	if span <= 0 {
+		return 0
+	}
+	offset := (pos*(s.total-s.visible) + span/2) / span
+	return clampOffset(offset, s.total, s.visible)
+}
@@ -0,0 +1,79 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+)
+
+func TestScrollThumb(t *testing.T) {
+	tests := []struct {
+		length, total, visible, offset int
+		pos, size                      int
+	}{
+		{10, 100, 10, 0, 0, 1},
+		{10, 100, 10, 90, 9, 1},
+		{10, 100, 10, 45, 4, 1},
+		{10, 20, 10, 0, 0, 5},
+		{10, 20, 10, 5, 2, 5},
+		{10, 20, 10, 10, 5, 5},
+		{10, 20, 10, 50, 5, 5}, // Offset past the end
+		{10, 1000, 5, 0, 0, 1}, // The thumb is at least a cell
+		{4, 5, 4, 1, 1, 3},
+		{10, 10, 10, 0, 0, 0}, // Everything fits
+		{10, 5, 10, 0, 0, 0},
+		{0, 100, 10, 0, 0, 0},
+		{10, 100, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		pos, size := ScrollThumb(tt.length, tt.total, tt.visible, tt.offset)
+		if pos != tt.pos || size != tt.size {
+			t.Errorf("ScrollThumb(%d, %d, %d, %d) = %d, %d, want %d, %d",
+				tt.length, tt.total, tt.visible, tt.offset, pos, size, tt.pos, tt.size)
+		}
+	}
+}
+
+func TestScrollbarRender(t *testing.T) {
+	s := NewScrollbar(layout.Vertical)
+	buf := screen.NewBuffer(1, 4, screen.DefaultDepth)
+	s.Render(buf, layout.NewRect(0, 0, 0, 1, 4), 8, 4, 4)
+	if got := buf.ToString(); got != "│\n│\n█\n█" {
+		t.Errorf("vertical = %q, want the thumb in the lower half", got)
+	}
+
+	s = NewScrollbar(layout.Horizontal)
+	buf = screen.NewBuffer(4, 1, screen.DefaultDepth)
+	s.Render(buf, layout.NewRect(0, 0, 0, 4, 1), 4, 4, 0)
+	if got := buf.ToString(); got != "" {
+		t.Errorf("horizontal with everything visible = %q, want nothing drawn", got)
+	}
+}
+
+func TestScrollbarDrag(t *testing.T) {
+	s := NewScrollbar(layout.Vertical)
+	parent := layout.NewRect(0, 0, 0, 5, 10)
+	s.Render(screen.NewBuffer(5, 10, screen.DefaultDepth), layout.NewRect(4, 0, 0, 1, 10), 20, 10, 0)
+
+	// Grab the thumb at its second cell and drag it down the track
+	if offset, ok := s.Drag(leftClick(4, 1, false), parent); !ok || offset != 0 {
+		t.Fatalf("press on the thumb = %d, %v, want 0, true", offset, ok)
+	}
+	if offset, _ := s.Drag(input.MouseEvent{X: 4, Y: 4, Button: input.MouseLeft, Motion: true}, parent); offset != 6 {
+		t.Errorf("drag by 3 cells = offset %d, want 6", offset)
+	}
+	if offset, _ := s.Drag(input.MouseEvent{X: 4, Y: 9, Button: input.MouseLeft, Motion: true}, parent); offset != 10 {
+		t.Errorf("drag past the end = offset %d, want 10", offset)
+	}
+	s.Drag(input.MouseEvent{X: 4, Y: 9, Button: input.MouseRelease}, parent)
+	if s.Dragging() {
+		t.Error("Dragging() after release = true")
+	}
+
+	// Presses off the bar are left alone
+	if _, ok := s.Drag(leftClick(2, 1, false), parent); ok {
+		t.Error("press beside the scrollbar was used")
+	}
+}
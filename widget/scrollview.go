@@ -0,0 +1,198 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+)
+
+// ScrollView shows a child taller than its bounds, scrolled vertically
+// The child is rendered at its preferred height and clipped to the view,
+// with a scrollbar in the last column while it overflows. Keys go to the
+// child first; the arrows, Page Up/Down, Home and End scroll the view
+// when the child doesn't use them. The mouse wheel scrolls the view
+// anywhere over it.
+type ScrollView struct {
+	BaseWidget
+	child         Widget
+	offset        int
+	wheel         wheelScroll
+	scrollbar     *Scrollbar
+	showScrollbar bool
+	contentHeight int // Height of the child at the last render
+	viewHeight    int // Height of the view at the last render
+	scroll        scrollNotifier
+}
+
+// NewScrollView creates a scroll view around child
+func NewScrollView(child Widget) *ScrollView {
+	v := &ScrollView{
+		BaseWidget:    NewBaseWidget(),
+		child:         child,
+		wheel:         newWheelScroll(),
+		scrollbar:     NewScrollbar(layout.Vertical),
+		showScrollbar: true,
+	}
+	v.SetInteractive(true)
+	return v
+}
+
+// Child returns the scrolled widget
+func (v *ScrollView) Child() Widget {
+	return v.child
+}
+
+// Children returns the scrolled widget
+func (v *ScrollView) Children() []Widget {
+	return []Widget{v.child}
+}
+
+// SetWheelStep sets how many lines one mouse wheel notch scrolls
+func (v *ScrollView) SetWheelStep(lines int) *ScrollView {
+	v.wheel.step = max(1, lines)
+	return v
+}
+
+// SetShowScrollbar sets whether a scrollbar is drawn while the child
+// overflows
+func (v *ScrollView) SetShowScrollbar(show bool) *ScrollView {
+	v.showScrollbar = show
+	return v
+}
+
+// OnScroll sets a callback for changes of the scroll offset, given the
+// offset and the visible and total number of lines
+func (v *ScrollView) OnScroll(fn func(offset, visible, total int)) *ScrollView {
+	v.scroll.fn = fn
+	return v
+}
+
+// Offset returns the number of lines scrolled past the top of the child
+func (v *ScrollView) Offset() int {
+	return v.offset
+}
+
+// ScrollTo scrolls so line offset of the child is at the top of the view
+func (v *ScrollView) ScrollTo(offset int) *ScrollView {
+	v.offset = clampOffset(offset, v.totalLines(), v.viewLines())
+	v.scroll.notify(v.offset, v.viewLines(), v.totalLines())
+	return v
+}
+
+// ScrollBy scrolls by lines, negative to scroll up
+func (v *ScrollView) ScrollBy(lines int) *ScrollView {
+	return v.ScrollTo(v.offset + lines)
+}
+
+// totalLines returns the height of the child, from the last render if there
+// was one
+func (v *ScrollView) totalLines() int {
+	if v.contentHeight > 0 {
+		return v.contentHeight
+	}
+	return v.child.Size().Height
+}
+
+// viewLines returns the height of the view at the last render, or its
+// preferred height before the first render
+func (v *ScrollView) viewLines() int {
+	if v.viewHeight > 0 {
+		return v.viewHeight
+	}
+	return v.child.Size().Height
+}
+
+// SetFocused sets the focus state of the view and the child
+func (v *ScrollView) SetFocused(focused bool) {
+	v.BaseWidget.SetFocused(focused)
+	v.child.SetFocused(focused)
+}
+
+// Render draws the visible part of the child
+func (v *ScrollView) Render(buf *screen.Buffer, bounds layout.Rect) {
+	v.bounds = bounds
+	if !v.visible || bounds.IsEmpty() {
+		return
+	}
+
+	v.viewHeight = bounds.Height
+	v.contentHeight = max(bounds.Height, v.child.Size().Height)
+	v.offset = clampOffset(v.offset, v.contentHeight, v.viewHeight)
+	v.scroll.notify(v.offset, v.viewHeight, v.contentHeight)
+
+	width := bounds.Width
+	overflow := v.contentHeight > v.viewHeight
+	if overflow && v.showScrollbar && width > 1 {
+		width--
+		scrollBounds := layout.NewRect(bounds.X+width, bounds.Y, bounds.Z, 1, bounds.Height)
+		v.scrollbar.Render(buf, scrollBounds, v.contentHeight, v.viewHeight, v.offset)
+	}
+
+	viewport := layout.NewRect(bounds.X, bounds.Y, bounds.Z, width, bounds.Height)
+	v.child.Render(buf.Clip(viewport), layout.NewRect(bounds.X, bounds.Y-v.offset, bounds.Z, width, v.contentHeight))
+}
+
+// HandleEvent handles input events
+func (v *ScrollView) HandleEvent(event input.Event) bool {
+	return v.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx passes events to the child along with ctx, scrolling on
+// the keys and mouse events it doesn't use
+func (v *ScrollView) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !v.visible {
+		return false
+	}
+	if lines := v.wheel.delta(event, v.bounds); lines != 0 {
+		if v.totalLines() <= v.viewLines() {
+			return false // Leave the wheel to an enclosing scroller
+		}
+		v.ScrollBy(lines)
+		return true
+	}
+	if e, ok := event.(input.MouseEvent); ok {
+		if offset, ok := v.scrollbar.Drag(e, v.bounds); ok {
+			v.ScrollTo(offset)
+			return true
+		}
+		return false
+	}
+	if !v.focused {
+		return false
+	}
+	if HandleEventCtx(v.child, ctx, event) {
+		return true
+	}
+
+	e, ok := event.(input.KeyEvent)
+	if !ok {
+		return false
+	}
+	switch e.Key {
+	case input.KeyUp:
+		v.ScrollBy(-1)
+	case input.KeyDown:
+		v.ScrollBy(1)
+	case input.KeyPageUp:
+		v.ScrollBy(-max(1, v.viewLines()-1))
+	case input.KeyPageDown:
+		v.ScrollBy(max(1, v.viewLines()-1))
+	case input.KeyHome:
+		v.ScrollTo(0)
+	case input.KeyEnd:
+		v.ScrollTo(v.totalLines())
+	default:
+		return false
+	}
+	return true
+}
+
+// Size returns the size of the child
+func (v *ScrollView) Size() layout.Size {
+	return v.child.Size()
+}
+
+// MinSize returns the minimum size
+func (v *ScrollView) MinSize() layout.Size {
+	return layout.NewSize(v.child.MinSize().Width, 1)
+}
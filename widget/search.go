@@ -1,6 +1,8 @@
 package widget
 
 import (
+	"time"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
@@ -18,6 +20,7 @@ type Search struct {
 	onChange    func(string)
 	onSubmit    func(string)
 	style       terminal.Style
+	debounce    debouncer
 }
 
 func NewSearch() *Search {
@@ -25,6 +28,7 @@ func NewSearch() *Search {
 		BaseWidget: NewBaseWidget(),
 		style: terminal.DefaultStyle(),
 		helpVisible: true,
+		debounce:    newDebouncer(),
 	}
 	s.interactive = true
 	return s
@@ -45,11 +49,47 @@ func (s *Search) SetValue(value string) *Search {
 	return s
 }
 
+// Value returns the current search text
+func (s *Search) Value() string {
+	return s.value
+}
+
 func (s *Search) SetOnChange(onChange func(string)) *Search {
 	s.onChange = onChange
 	return s
 }
 
+// SetChangeDebounce delays the change callback until typing has paused for d
+// Pending changes are delivered by Tick; Value always returns the current text
+func (s *Search) SetChangeDebounce(d time.Duration) *Search {
+	s.debounce.delay = d
+	return s
+}
+
+// Tick delivers a debounced change once typing has paused
+// Returns true if the change callback was called
+func (s *Search) Tick(now time.Time) bool {
+	if !s.debounce.ready(now) {
+		return false
+	}
+	s.callOnChange()
+	return true
+}
+
+// notifyChange calls the change callback, unless it is debounced
+func (s *Search) notifyChange() {
+	if s.debounce.change() {
+		s.callOnChange()
+	}
+}
+
+// callOnChange calls the change callback
+func (s *Search) callOnChange() {
+	if s.onChange != nil {
+		s.onChange(s.value)
+	}
+}
+
 func (s *Search) SetOnSubmit(onSubmit func(string)) *Search {
 	s.onSubmit = onSubmit
 	return s
@@ -105,12 +145,16 @@ func (s *Search) SetStyle(style terminal.Style) *Search {
 
 // Meet interface for Widget
 func (s *Search) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !s.IsVisible() {
+	s.bounds = bounds
+	if !s.IsVisible() || bounds.IsEmpty() {
 		return
 	}
 
 	// inset bounds for inner content
 	bounds = bounds.InsetAll(1)
+	if bounds.IsEmpty() {
+		return
+	}
 	// layout search and help
 	flexLayout := layout.NewVFlex()
 	flexSearch := layout.NewFixedChild(3)
@@ -119,8 +163,8 @@ func (s *Search) Render(buf *screen.Buffer, bounds layout.Rect) {
 		flexSearch,
 		flexHelp,
 	})
-	searchBounds := rects[0]
-	helpBounds := rects[1]
+	searchBounds := rects[0].Intersection(bounds)
+	helpBounds := rects[1].Intersection(bounds)
 
 	// draw search box
 	buf.DrawBox(searchBounds.X, searchBounds.Y, searchBounds.Z, searchBounds.Width, searchBounds.Height, s.style)
@@ -132,10 +176,10 @@ func (s *Search) Render(buf *screen.Buffer, bounds layout.Rect) {
 	
 	if s.value == "" && !s.focused {
 		// Show placeholder when empty and not focused
-		buf.DrawString(searchBounds.X, searchBounds.Y, searchBounds.Z, s.placeholder, s.style.WithDim())
+		buf.DrawStringClipped(searchBounds.X, searchBounds.Y, searchBounds.Z, s.placeholder, s.style.WithDim(), searchBounds.Width)
 	} else {
 		// Draw the value
-		buf.DrawString(searchBounds.X, searchBounds.Y, searchBounds.Z, s.value, displayStyle)
+		buf.DrawStringClipped(searchBounds.X, searchBounds.Y, searchBounds.Z, s.value, displayStyle, searchBounds.Width)
 	}
 	
 	// Draw cursor if focused
@@ -151,7 +195,7 @@ func (s *Search) Render(buf *screen.Buffer, bounds layout.Rect) {
 		}
 	}
 
-	if s.helpVisible {
+	if s.helpVisible && !helpBounds.IsEmpty() {
 		s.help = s.getHelp(helpBounds.X)
 		s.help.Render(buf, helpBounds)
 	}
@@ -163,6 +207,9 @@ func (s *Search) HandleEvent(event input.Event) bool {
 		return false
 	}
 	if event_key.Key == input.KeyEnter {
+		if s.debounce.flush() {
+			s.callOnChange()
+		}
 		if s.onSubmit != nil {
 			s.onSubmit(s.value)
 		}
@@ -175,15 +222,18 @@ func (s *Search) HandleEvent(event input.Event) bool {
 		if s.cursor >= len(s.value) {
 			s.value = s.value[:s.cursor-1]
 			s.cursor--
+			s.notifyChange()
 			return true
 		}
 		s.value = s.value[:s.cursor-1] + s.value[s.cursor:]
 		s.cursor--
+		s.notifyChange()
 		return true
 	}
 	if event_key.Key == input.KeyDelete {
 		if s.cursor < len(s.value) {
 			s.value = s.value[:s.cursor] + s.value[s.cursor+1:]
+			s.notifyChange()
 		}
 		return true
 	}
@@ -212,20 +262,24 @@ func (s *Search) HandleEvent(event input.Event) bool {
 		if len(s.value) == 0 {
 			s.value = string(event_key.Rune)
 			s.cursor = 1
+			s.notifyChange()
 			return true
 		}
 		if len(s.value) == s.cursor {
 			s.value = s.value + string(event_key.Rune)
 			s.cursor++
+			s.notifyChange()
 			return true
 		}
 		if s.cursor == 0 {
 			s.value = string(event_key.Rune) + s.value
 			s.cursor = 1
+			s.notifyChange()
 			return true
 		}
 		s.value = s.value[:s.cursor] + string(event_key.Rune) + s.value[s.cursor:]
 		s.cursor++
+		s.notifyChange()
 		return true
 	}
 	return false
@@ -250,3 +304,8 @@ func (s *Search) IsFocused() bool {
 func (s *Search) IsInteractive() bool {
 	return s.BaseWidget.IsInteractive()
 }
+
+// AccessibleDescription describes the search box and its query
+func (s *Search) AccessibleDescription() string {
+	return "Search value=" + quote(s.value) + focusState(s)
+}
@@ -1,24 +1,40 @@
 package widget
 
 import (
+	"strconv"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
 )
 
+// searchAndResultsGap is the number of rows between the search box and the
+// results, the middle one holding the status line
+const searchAndResultsGap = 3
+
+// SearchAndResults stacks a search box above a results table, with a status
+// line between them showing the result count and query
+// Tab moves focus between the two, and keys go only to the focused one.
 type SearchAndResults struct {
 	BaseWidget
-	search *Search
-	results *Table
-	searchFocused bool
-	resultsFocused bool
+	search          *Search
+	results         *Table
+	searchHeight    int
+	statusFormatter func(query string, count int) string
+	filter          func(query string) [][]string
+	statusStyle     terminal.Style
+	resultsFocused  bool // Focus is on the results rather than the search box
 }
 
 func NewSearchAndResults() *SearchAndResults {
 	s := &SearchAndResults{
-		BaseWidget: NewBaseWidget(),
-		search: NewSearch(),
-		results: NewTable(),
+		BaseWidget:      NewBaseWidget(),
+		search:          NewSearch(),
+		results:         NewTable(),
+		searchHeight:    12,
+		statusFormatter: defaultSearchStatus,
+		statusStyle:     terminal.DefaultStyle().WithDim(),
 	}
 	s.interactive = true
 	return s
@@ -26,6 +42,9 @@ func NewSearchAndResults() *SearchAndResults {
 
 func (s *SearchAndResults) SetSearch(search *Search) *SearchAndResults {
 	s.search = search
+	if s.filter != nil {
+		s.search.SetOnChange(s.applyFilter)
+	}
 	return s
 }
 
@@ -34,11 +53,95 @@ func (s *SearchAndResults) SetTable(table *Table) *SearchAndResults {
 	return s
 }
 
+// SetFilter sets the function computing the results for a query
+// It runs whenever the query changes, after the search box's change
+// debounce, replacing the table rows and moving the selection to the top.
+// It takes over the search box's change callback.
+func (s *SearchAndResults) SetFilter(filter func(query string) [][]string) *SearchAndResults {
+	s.filter = filter
+	s.search.SetOnChange(s.applyFilter)
+	s.applyFilter(s.search.Value())
+	return s
+}
+
+// applyFilter shows the results of the filter for query
+func (s *SearchAndResults) applyFilter(query string) {
+	if s.filter == nil {
+		return
+	}
+	s.results.SetRows(s.filter(query))
+	s.results.SelectRow(0)
+}
+
+// SetSearchHeight sets the number of rows given to the search box
+func (s *SearchAndResults) SetSearchHeight(height int) *SearchAndResults {
+	s.searchHeight = height
+	return s
+}
+
+// SetStatusFormatter sets how the status line shows the query and the
+// number of results; an empty string hides it
+func (s *SearchAndResults) SetStatusFormatter(fn func(query string, count int) string) *SearchAndResults {
+	s.statusFormatter = fn
+	return s
+}
+
+// SetStatusStyle sets the style of the status line
+func (s *SearchAndResults) SetStatusStyle(style terminal.Style) *SearchAndResults {
+	s.statusStyle = style
+	return s
+}
+
+// Status returns the status line for the current query and results
+func (s *SearchAndResults) Status() string {
+	if s.statusFormatter == nil {
+		return ""
+	}
+	return s.statusFormatter(s.search.Value(), s.results.rowCount())
+}
+
+// defaultSearchStatus formats the status line as e.g. `42 results for "go"`
+func defaultSearchStatus(query string, count int) string {
+	status := strconv.Itoa(count) + " results"
+	if count == 1 {
+		status = "1 result"
+	}
+	if query != "" {
+		status += " for " + strconv.Quote(query)
+	}
+	return status
+}
+
+// FocusResults moves focus to the results table, or back to the search box
+func (s *SearchAndResults) FocusResults(results bool) *SearchAndResults {
+	s.resultsFocused = results
+	s.SetFocused(s.focused)
+	return s
+}
+
+// ResultsFocused returns true if the results table has focus rather than
+// the search box
+func (s *SearchAndResults) ResultsFocused() bool {
+	return s.resultsFocused
+}
+
+// Children returns the search box and the results table
+func (s *SearchAndResults) Children() []Widget {
+	return []Widget{s.search, s.results}
+}
+
+// SetVisibleRecursive sets the visibility of the widget and its children
+func (s *SearchAndResults) SetVisibleRecursive(visible bool) {
+	setVisibleRecursive(s, visible)
+}
 
 func (s *SearchAndResults) Render(buf *screen.Buffer, bounds layout.Rect) {
-	search_height := 12
-	vFlex := layout.NewVFlex().WithGap(2)
-	searchFlex := layout.NewFixedChild(search_height)
+	s.bounds = bounds
+	if !s.visible || bounds.IsEmpty() {
+		return
+	}
+	vFlex := layout.NewVFlex().WithGap(searchAndResultsGap)
+	searchFlex := layout.NewFixedChild(s.searchHeight)
 	resultsFlex := layout.NewFlexChild(10)
 	rects := vFlex.Layout(bounds, []layout.FlexChild{
 		searchFlex,
@@ -46,33 +149,60 @@ func (s *SearchAndResults) Render(buf *screen.Buffer, bounds layout.Rect) {
 	})
 	search_bounds := rects[0]
 	results_bounds := rects[1]
-	s.search.Render(buf, search_bounds.InsetAll(1))
-	s.results.Render(buf, results_bounds.InsetAll(1))
+	search_inner := search_bounds.InsetAll(1)
+	results_inner := results_bounds.InsetAll(1)
+	s.search.Render(buf.Clip(search_inner), search_inner)
+	s.results.Render(buf.Clip(results_inner), results_inner)
+
+	statusY := search_bounds.Y + search_bounds.Height + searchAndResultsGap/2
+	if status := s.Status(); status != "" && statusY >= bounds.Y && statusY < results_bounds.Y {
+		buf.DrawStringClipped(bounds.X+1, statusY, bounds.Z, status, s.statusStyle, bounds.Width-2)
+	}
 }
 
 func (s *SearchAndResults) HandleEvent(event input.Event) bool {
-	return s.search.HandleEvent(event) || s.results.HandleEvent(event)
+	return s.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx passes events to the focused child along with ctx
+func (s *SearchAndResults) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !s.visible {
+		return false
+	}
+	if e, ok := event.(input.KeyEvent); ok && e.Key == input.KeyTab && s.focused {
+		s.FocusResults(!s.resultsFocused)
+		return true
+	}
+	if s.resultsFocused {
+		return HandleEventCtx(s.results, ctx, event)
+	}
+	return HandleEventCtx(s.search, ctx, event)
 }
 
+// Size returns the preferred size, with the search box above the results
 func (s *SearchAndResults) Size() layout.Size {
 	search_size := s.search.Size()
 	results_size := s.results.Size()
-	return layout.NewSize(search_size.Width + results_size.Width, search_size.Height + results_size.Height)
+	return layout.NewSize(max(search_size.Width, results_size.Width), search_size.Height+searchAndResultsGap+results_size.Height)
 }
 
+// MinSize returns the minimum size, with the search box above the results
 func (s *SearchAndResults) MinSize() layout.Size {
 	search_min_size := s.search.MinSize()
 	results_min_size := s.results.MinSize()
-	return layout.NewSize(search_min_size.Width + results_min_size.Width, search_min_size.Height + results_min_size.Height)
+	return layout.NewSize(max(search_min_size.Width, results_min_size.Width), search_min_size.Height+searchAndResultsGap+results_min_size.Height)
 }
 
+// SetFocused sets the focus state, passing it to whichever of the search
+// box and results has focus
 func (s *SearchAndResults) SetFocused(focused bool) {
-	s.search.SetFocused(focused)
-	s.results.SetFocused(focused)
+	s.focused = focused
+	s.search.SetFocused(focused && !s.resultsFocused)
+	s.results.SetFocused(focused && s.resultsFocused)
 }
 
 func (s *SearchAndResults) IsFocused() bool {
-	return s.search.IsFocused() || s.results.IsFocused()
+	return s.focused
 }
 
 func (s *SearchAndResults) IsInteractive() bool {
@@ -0,0 +1,141 @@
+package widget
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+)
+
+// newTestSearchAndResults returns a focused search over a table of n rows
+func newTestSearchAndResults(n int) *SearchAndResults {
+	s := NewSearchAndResults().
+		SetTable(newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(n))).
+		SetSearchHeight(5)
+	s.SetFocused(true)
+	return s
+}
+
+func TestSearchAndResultsTabSwitchesFocus(t *testing.T) {
+	s := newTestSearchAndResults(5)
+	if s.ResultsFocused() || !s.search.IsFocused() || s.results.IsFocused() {
+		t.Fatal("focus does not start on the search box")
+	}
+	tab := input.KeyEvent{Key: input.KeyTab}
+	s.HandleEvent(tab)
+	if !s.ResultsFocused() || s.search.IsFocused() || !s.results.IsFocused() {
+		t.Error("Tab did not move focus to the results")
+	}
+	s.HandleEvent(tab)
+	if s.ResultsFocused() || !s.search.IsFocused() {
+		t.Error("second Tab did not move focus back to the search box")
+	}
+}
+
+func TestSearchAndResultsRoutesKeysToFocusedChild(t *testing.T) {
+	s := newTestSearchAndResults(5)
+	s.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'j'})
+	s.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	if s.search.Value() != "j" || s.results.SelectedRow() != 0 {
+		t.Errorf("query, row = %q, %d, want %q, 0", s.search.Value(), s.results.SelectedRow(), "j")
+	}
+
+	s.HandleEvent(input.KeyEvent{Key: input.KeyTab})
+	s.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	s.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'k'})
+	if s.search.Value() != "j" || s.results.SelectedRow() != 1 {
+		t.Errorf("query, row = %q, %d, want %q, 1", s.search.Value(), s.results.SelectedRow(), "j")
+	}
+}
+
+func TestSearchAndResultsSizeStacks(t *testing.T) {
+	s := newTestSearchAndResults(5)
+	search, results := s.search.Size(), s.results.Size()
+	want := layout.NewSize(max(search.Width, results.Width), search.Height+searchAndResultsGap+results.Height)
+	if got := s.Size(); got != want {
+		t.Errorf("Size() = %v, want %v", got, want)
+	}
+}
+
+func TestSearchAndResultsStatus(t *testing.T) {
+	s := newTestSearchAndResults(42)
+	if got := s.Status(); got != "42 results" {
+		t.Errorf("Status() = %q, want %q", got, "42 results")
+	}
+	typeText(s, "go")
+	s.results.SetRows(numberedRows(1))
+	if got := s.Status(); got != `1 result for "go"` {
+		t.Errorf("Status() = %q, want %q", got, `1 result for "go"`)
+	}
+
+	// The status sits on the middle row of the gap below the search box
+	lines := strings.Split(renderWidget(s, 30, 12).ToString(), "\n")
+	if got := lines[6]; got != ` 1 result for "go"` {
+		t.Errorf("status row = %q, want %q", got, ` 1 result for "go"`)
+	}
+
+	s.SetStatusFormatter(func(query string, count int) string { return query + ":" + strconv.Itoa(count) })
+	if got := s.Status(); got != "go:1" {
+		t.Errorf("Status() with a formatter = %q, want %q", got, "go:1")
+	}
+	s.SetStatusFormatter(func(string, int) string { return "" })
+	if got := strings.Split(renderWidget(s, 30, 12).ToString(), "\n")[6]; got != "" {
+		t.Errorf("status row with an empty status = %q, want it blank", got)
+	}
+}
+
+func TestSearchAndResultsFilter(t *testing.T) {
+	fruits := []string{"apple", "apricot", "banana"}
+	s := newTestSearchAndResults(0).SetFilter(func(query string) [][]string {
+		var rows [][]string
+		for _, f := range fruits {
+			if strings.HasPrefix(f, query) {
+				rows = append(rows, []string{f})
+			}
+		}
+		return rows
+	})
+	if got := s.results.rowCount(); got != 3 {
+		t.Fatalf("rows before typing = %d, want 3", got)
+	}
+
+	// The selection moves back to the top as the results change
+	s.FocusResults(true)
+	s.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	s.FocusResults(false)
+	typeText(s, "ap")
+	if got := s.results.rowCount(); got != 2 || s.results.SelectedRow() != 0 {
+		t.Errorf("rows, selected after ap = %d, %d, want 2, 0", got, s.results.SelectedRow())
+	}
+	typeText(s, "r")
+	if got := s.results.rows.Row(0)[0]; s.results.rowCount() != 1 || got != "apricot" {
+		t.Errorf("rows after apr = %d starting %q, want 1 starting apricot", s.results.rowCount(), got)
+	}
+	s.HandleEvent(input.KeyEvent{Key: input.KeyBackspace})
+	s.HandleEvent(input.KeyEvent{Key: input.KeyBackspace})
+	s.HandleEvent(input.KeyEvent{Key: input.KeyBackspace})
+	if got := s.results.rowCount(); got != 3 {
+		t.Errorf("rows after clearing the query = %d, want 3", got)
+	}
+}
+
+func TestSearchAndResultsFilterDebounced(t *testing.T) {
+	calls := 0
+	search := NewSearch().SetChangeDebounce(100 * time.Millisecond)
+	s := newTestSearchAndResults(0).SetSearch(search).SetFilter(func(string) [][]string {
+		calls++
+		return nil
+	})
+	calls = 0
+	typeText(s, "abc")
+	if calls != 0 {
+		t.Fatalf("filter ran %d times while typing, want 0", calls)
+	}
+	search.Tick(time.Now().Add(time.Second))
+	if calls != 1 {
+		t.Errorf("filter ran %d times after the pause, want 1", calls)
+	}
+}
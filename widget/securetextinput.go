@@ -0,0 +1,220 @@
+package widget
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// SecureTextInput is a masked single-line input for passwords and other
+// secrets
+// The text is kept as UTF-8 bytes that are zeroed when removed, when the
+// buffer grows and on Clear, and is only handed out through WithValue, so
+// no copies are left behind in strings. Editing is limited to typing at
+// the end, Backspace and Ctrl+U.
+type SecureTextInput struct {
+	BaseWidget
+	value        []byte
+	runes        int // Number of runes in value
+	placeholder  string
+	mask         rune
+	style        terminal.Style
+	focusedStyle terminal.Style
+	cursorStyle  terminal.Style
+	width        int
+	onSubmit     func()
+}
+
+// NewSecureTextInput creates an empty secure text input masked with '*'
+func NewSecureTextInput() *SecureTextInput {
+	s := &SecureTextInput{
+		BaseWidget:   NewBaseWidget(),
+		mask:         '*',
+		style:        terminal.DefaultStyle(),
+		focusedStyle: terminal.DefaultStyle().WithReverse(),
+		cursorStyle:  terminal.DefaultStyle().WithReverse(),
+		width:        20,
+	}
+	s.SetInteractive(true)
+	return s
+}
+
+// WithValue calls fn with the text
+// fn must not keep the slice, which is wiped by later edits and Clear.
+func (s *SecureTextInput) WithValue(fn func(value []byte)) {
+	fn(s.value)
+}
+
+// Len returns the number of characters entered
+func (s *SecureTextInput) Len() int {
+	return s.runes
+}
+
+// Clear zeroes the text and empties the input
+func (s *SecureTextInput) Clear() *SecureTextInput {
+	wipe(s.value[:cap(s.value)])
+	s.value = s.value[:0]
+	s.runes = 0
+	return s
+}
+
+// SetPlaceholder sets placeholder text
+func (s *SecureTextInput) SetPlaceholder(placeholder string) *SecureTextInput {
+	s.placeholder = placeholder
+	return s
+}
+
+// SetMask sets the character drawn in place of each entered character
+func (s *SecureTextInput) SetMask(mask rune) *SecureTextInput {
+	s.mask = mask
+	return s
+}
+
+// SetWidth sets the input width
+func (s *SecureTextInput) SetWidth(width int) *SecureTextInput {
+	s.width = width
+	return s
+}
+
+// SetStyle sets the normal style
+func (s *SecureTextInput) SetStyle(style terminal.Style) *SecureTextInput {
+	s.style = style
+	return s
+}
+
+// SetFocusedStyle sets the focused style
+func (s *SecureTextInput) SetFocusedStyle(style terminal.Style) *SecureTextInput {
+	s.focusedStyle = style
+	return s
+}
+
+// OnSubmit sets the callback for Enter; read the text with WithValue
+func (s *SecureTextInput) OnSubmit(fn func()) *SecureTextInput {
+	s.onSubmit = fn
+	return s
+}
+
+// wipe zeroes b
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// insert appends r, moving the text to a larger buffer and zeroing the old
+// one when it is full
+func (s *SecureTextInput) insert(r rune) {
+	n := utf8.RuneLen(r)
+	if n < 0 {
+		return
+	}
+	if len(s.value)+n > cap(s.value) {
+		grown := make([]byte, len(s.value), max(16, 2*cap(s.value)+n))
+		copy(grown, s.value)
+		wipe(s.value[:cap(s.value)])
+		s.value = grown
+	}
+	s.value = utf8.AppendRune(s.value, r)
+	s.runes++
+}
+
+// backspace removes and zeroes the last character
+func (s *SecureTextInput) backspace() {
+	if len(s.value) == 0 {
+		return
+	}
+	_, n := utf8.DecodeLastRune(s.value)
+	end := len(s.value) - n
+	wipe(s.value[end:])
+	s.value = s.value[:end]
+	s.runes--
+}
+
+// Render draws the masked text, scrolled to keep the end in view
+func (s *SecureTextInput) Render(buf *screen.Buffer, bounds layout.Rect) {
+	s.bounds = bounds
+	if !s.visible || bounds.IsEmpty() {
+		return
+	}
+
+	style := s.style
+	if s.focused {
+		style = s.focusedStyle
+	}
+	width := bounds.Width
+	if s.width > 0 && s.width < width {
+		width = s.width
+	}
+	buf.FillRect(bounds.X, bounds.Y, bounds.Z, width, 1, screen.NewCell(' ', style))
+
+	if s.runes == 0 && !s.focused {
+		buf.DrawStringClipped(bounds.X, bounds.Y, bounds.Z, s.placeholder, style.WithDim(), width)
+		return
+	}
+
+	// Leave a cell for the cursor after the text while focused
+	shown := s.runes
+	if s.focused {
+		shown = min(shown, width-1)
+	} else {
+		shown = min(shown, width)
+	}
+	if shown > 0 {
+		buf.DrawStringClipped(bounds.X, bounds.Y, bounds.Z, strings.Repeat(string(s.mask), shown), style, width)
+	}
+	if s.focused && shown < width {
+		buf.Set(bounds.X+shown, bounds.Y, bounds.Z, screen.NewCell(' ', s.cursorStyle))
+	}
+}
+
+// HandleEvent handles input events
+func (s *SecureTextInput) HandleEvent(event input.Event) bool {
+	if !s.focused {
+		return false
+	}
+	e, ok := event.(input.KeyEvent)
+	if !ok {
+		return false
+	}
+
+	switch {
+	case e.Key == input.KeyEnter:
+		if s.onSubmit != nil {
+			s.onSubmit()
+		}
+		return true
+	case e.Key == input.KeyBackspace:
+		s.backspace()
+		return true
+	case e.Key == input.KeyRune && e.IsCtrl():
+		if e.Rune == 'u' {
+			s.Clear()
+			return true
+		}
+		return false
+	case e.Key == input.KeyRune:
+		s.insert(e.Rune)
+		return true
+	}
+	return false
+}
+
+// Size returns the preferred size
+func (s *SecureTextInput) Size() layout.Size {
+	return layout.NewSize(s.width, 1)
+}
+
+// MinSize returns the minimum size
+func (s *SecureTextInput) MinSize() layout.Size {
+	return layout.NewSize(5, 1)
+}
+
+// AccessibleDescription describes the input by its length only
+func (s *SecureTextInput) AccessibleDescription() string {
+	return fmt.Sprintf("SecureTextInput length=%d%s", s.runes, focusState(s))
+}
@@ -0,0 +1,71 @@
+package widget
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// isZero returns whether every byte of b is zero
+func isZero(b []byte) bool {
+	return bytes.Count(b, []byte{0}) == len(b)
+}
+
+func TestSecureTextInputClearZeroes(t *testing.T) {
+	s := NewSecureTextInput()
+	s.SetFocused(true)
+	typeText(s, "hunter2é")
+	var got string
+	s.WithValue(func(v []byte) { got = string(v) })
+	if got != "hunter2é" || s.Len() != 8 {
+		t.Fatalf("value, Len() = %q, %d, want %q, 8", got, s.Len(), "hunter2é")
+	}
+
+	backing := s.value[:cap(s.value)]
+	s.Clear()
+	if !isZero(backing) {
+		t.Errorf("buffer after Clear = %v, want zeroed", backing)
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() after Clear = %d, want 0", s.Len())
+	}
+}
+
+func TestSecureTextInputWipesOldBuffers(t *testing.T) {
+	s := NewSecureTextInput()
+	s.SetFocused(true)
+	typeText(s, "0123456789abcdef") // Fills the first buffer
+	first := s.value[:cap(s.value)]
+	typeText(s, "g")
+	if !isZero(first) {
+		t.Errorf("outgrown buffer = %q, want zeroed", first)
+	}
+
+	end := len(s.value)
+	s.HandleEvent(input.KeyEvent{Key: input.KeyBackspace})
+	if s.value[:end][end-1] != 0 {
+		t.Error("Backspace left the removed byte in the buffer")
+	}
+	backing := s.value[:cap(s.value)]
+	s.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'u', Modifier: input.ModCtrl})
+	if s.Len() != 0 || !isZero(backing) {
+		t.Errorf("Ctrl+U left %d characters, buffer zeroed = %v", s.Len(), isZero(backing))
+	}
+}
+
+func TestSecureTextInputMasks(t *testing.T) {
+	s := NewSecureTextInput().SetPlaceholder("Password")
+	if got := renderWidget(s, 10, 1).ToString(); got != "Password" {
+		t.Errorf("empty render = %q, want the placeholder", got)
+	}
+	s.SetFocused(true)
+	typeText(s, "pässwörd")
+	if got := renderWidget(s, 10, 1).ToString(); got != "********" {
+		t.Errorf("render = %q, want 8 masks", got)
+	}
+	s.SetMask('•')
+	if got := renderWidget(s, 5, 1).ToString(); got != "••••" {
+		t.Errorf("narrow render = %q, want 4 masks and the cursor", got)
+	}
+}
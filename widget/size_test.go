@@ -0,0 +1,28 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/layout"
+)
+
+func TestSizeUsesDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		w    Widget
+		want layout.Size
+	}{
+		{"Button accented", NewButton("Déjà vu"), layout.NewSize(11, 1)},
+		{"Button CJK", NewButton("確認"), layout.NewSize(8, 1)},
+		{"List", NewList().SetStrings([]string{"naïve", "東京都"}), layout.NewSize(6, 2)},
+		{"Menu", NewMenu().SetShowBorder(false).SetItems([]*MenuItem{
+			{Label: "Ouvrir…", Shortcut: "⌘O"}, {Label: "保存"},
+		}), layout.NewSize(11, 2)},
+		{"Progress", NewProgress().SetWidth(10).SetLabel("進捗"), layout.NewSize(21, 1)}, // With the percentage
+	}
+	for _, tt := range tests {
+		if got := tt.w.Size(); got != tt.want {
+			t.Errorf("%s: Size() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
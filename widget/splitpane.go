@@ -0,0 +1,356 @@
+package widget
+
+import (
+	"math"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// Pane identifies a focus target within a SplitPane
+type Pane int
+
+const (
+	// PaneFirst is the left or top pane
+	PaneFirst Pane = iota
+	// PaneSecond is the right or bottom pane
+	PaneSecond
+	// PaneDivider is the divider between the panes, resizable with the arrow keys
+	PaneDivider
+)
+
+// SplitPane shows two widgets side by side (Horizontal) or stacked
+// (Vertical) with a divider between them
+// The toggle key (F6 by default) cycles focus between the first pane, the
+// second pane and the divider. While the divider is focused the arrow keys
+// move it; it can also be dragged with the mouse.
+type SplitPane struct {
+	BaseWidget
+	direction    layout.Direction
+	first        Widget
+	second       Widget
+	ratio        float64 // Share of the space given to the first pane
+	fixed        int     // Fixed size of the first pane; overrides ratio when > 0
+	minFirst     int
+	minSecond    int
+	focusedPane  Pane
+	toggleKey    input.Key
+	style        terminal.Style
+	focusedStyle terminal.Style
+	lastBounds   layout.Rect // Bounds from the last render, for mouse handling
+	dragging     bool
+}
+
+// NewSplitPane creates a split pane with the divider in the middle
+func NewSplitPane(direction layout.Direction, first, second Widget) *SplitPane {
+	s := &SplitPane{
+		BaseWidget:   NewBaseWidget(),
+		direction:    direction,
+		first:        first,
+		second:       second,
+		ratio:        0.5,
+		minFirst:     1,
+		minSecond:    1,
+		toggleKey:    input.KeyF6,
+		style:        terminal.DefaultStyle(),
+		focusedStyle: terminal.DefaultStyle().WithReverse(),
+	}
+	s.SetInteractive(true)
+	return s
+}
+
+// SetRatio sets the share of the space given to the first pane (0 to 1)
+func (s *SplitPane) SetRatio(ratio float64) *SplitPane {
+	s.ratio = math.Max(0, math.Min(1, ratio))
+	s.fixed = 0
+	return s
+}
+
+// Ratio returns the share of the space given to the first pane
+func (s *SplitPane) Ratio() float64 {
+	return s.ratio
+}
+
+// SetFirstSize gives the first pane a fixed size in cells
+// A size of 0 switches back to the ratio
+func (s *SplitPane) SetFirstSize(size int) *SplitPane {
+	s.fixed = max(0, size)
+	return s
+}
+
+// SetMinSizes sets the minimum sizes of the panes along the split direction
+func (s *SplitPane) SetMinSizes(first, second int) *SplitPane {
+	s.minFirst = max(0, first)
+	s.minSecond = max(0, second)
+	return s
+}
+
+// SetToggleKey sets the key that cycles focus between the panes and the divider
+func (s *SplitPane) SetToggleKey(key input.Key) *SplitPane {
+	s.toggleKey = key
+	return s
+}
+
+// SetStyle sets the divider style
+func (s *SplitPane) SetStyle(style terminal.Style) *SplitPane {
+	s.style = style
+	return s
+}
+
+// SetFocusedStyle sets the divider style while it is focused or dragged
+func (s *SplitPane) SetFocusedStyle(style terminal.Style) *SplitPane {
+	s.focusedStyle = style
+	return s
+}
+
+// First returns the left or top widget
+func (s *SplitPane) First() Widget {
+	return s.first
+}
+
+// Second returns the right or bottom widget
+func (s *SplitPane) Second() Widget {
+	return s.second
+}
+
+// FocusPane moves focus to a pane or the divider
+func (s *SplitPane) FocusPane(pane Pane) *SplitPane {
+	if s.focused {
+		s.setPaneFocus(s.focusedPane, false)
+		s.setPaneFocus(pane, true)
+	}
+	s.focusedPane = pane
+	return s
+}
+
+// FocusedPane returns the focused pane or divider
+func (s *SplitPane) FocusedPane() Pane {
+	return s.focusedPane
+}
+
+// setPaneFocus sets the focus state of a pane's widget
+func (s *SplitPane) setPaneFocus(pane Pane, focused bool) {
+	switch pane {
+	case PaneFirst:
+		if s.first != nil {
+			s.first.SetFocused(focused)
+		}
+	case PaneSecond:
+		if s.second != nil {
+			s.second.SetFocused(focused)
+		}
+	}
+}
+
+// SetFocused sets focus state, passing it on to the focused pane
+func (s *SplitPane) SetFocused(focused bool) {
+	s.BaseWidget.SetFocused(focused)
+	s.setPaneFocus(s.focusedPane, focused)
+}
+
+// span returns the size of bounds along the split direction
+func (s *SplitPane) span(bounds layout.Rect) int {
+	if s.direction == layout.Horizontal {
+		return bounds.Width
+	}
+	return bounds.Height
+}
+
+// firstSize returns the size of the first pane for a total span,
+// clamped so both panes keep their minimum sizes where possible
+func (s *SplitPane) firstSize(total int) int {
+	available := max(0, total-1) // One cell for the divider
+	size := s.fixed
+	if size <= 0 {
+		size = int(math.Round(s.ratio * float64(available)))
+	}
+	size = min(size, available-s.minSecond)
+	size = max(size, s.minFirst)
+	return max(0, min(size, available))
+}
+
+// PaneBounds splits bounds into the first pane, divider and second pane
+func (s *SplitPane) PaneBounds(bounds layout.Rect) (first, divider, second layout.Rect) {
+	size := s.firstSize(s.span(bounds))
+	if s.direction == layout.Horizontal {
+		first = layout.NewRect(bounds.X, bounds.Y, bounds.Z, size, bounds.Height)
+		divider = layout.NewRect(bounds.X+size, bounds.Y, bounds.Z, min(1, bounds.Width-size), bounds.Height)
+		second = layout.NewRect(bounds.X+size+1, bounds.Y, bounds.Z, max(0, bounds.Width-size-1), bounds.Height)
+		return first, divider, second
+	}
+	first = layout.NewRect(bounds.X, bounds.Y, bounds.Z, bounds.Width, size)
+	divider = layout.NewRect(bounds.X, bounds.Y+size, bounds.Z, bounds.Width, min(1, bounds.Height-size))
+	second = layout.NewRect(bounds.X, bounds.Y+size+1, bounds.Z, bounds.Width, max(0, bounds.Height-size-1))
+	return first, divider, second
+}
+
+// moveDivider sets the first pane's size, keeping ratio or fixed mode
+func (s *SplitPane) moveDivider(size int) {
+	total := s.span(s.lastBounds)
+	available := total - 1
+	if available <= 0 {
+		return
+	}
+	size = max(s.minFirst, min(size, available-s.minSecond))
+	size = max(0, min(size, available))
+	if s.fixed > 0 {
+		s.fixed = max(1, size)
+		return
+	}
+	s.ratio = float64(size) / float64(available)
+}
+
+// Render draws both panes and the divider
+func (s *SplitPane) Render(buf *screen.Buffer, bounds layout.Rect) {
+	s.bounds = bounds
+	if !s.visible || bounds.IsEmpty() {
+		return
+	}
+	s.lastBounds = bounds
+
+	first, divider, second := s.PaneBounds(bounds)
+	if s.first != nil && !first.IsEmpty() {
+		s.first.Render(buf.Clip(first), first)
+	}
+	if s.second != nil && !second.IsEmpty() {
+		s.second.Render(buf.Clip(second), second)
+	}
+
+	style := s.style
+	if s.dragging || (s.focused && s.focusedPane == PaneDivider) {
+		style = s.focusedStyle
+	}
+	if divider.IsEmpty() {
+		return
+	}
+	borders := screen.DefaultBorders()
+	if s.direction == layout.Horizontal {
+		buf.DrawVLine(divider.X, divider.Y, divider.Z, divider.Height, borders.Vertical, style)
+	} else {
+		buf.DrawHLine(divider.X, divider.Y, divider.Z, divider.Width, borders.Horizontal, style)
+	}
+}
+
+// HandleEvent handles input events
+func (s *SplitPane) HandleEvent(event input.Event) bool {
+	return s.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx handles input events, passing ctx to the panes
+func (s *SplitPane) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !s.visible || !s.focused {
+		return false
+	}
+
+	switch e := event.(type) {
+	case input.KeyEvent:
+		if e.Key == s.toggleKey {
+			s.FocusPane((s.focusedPane + 1) % 3)
+			return true
+		}
+		if s.focusedPane == PaneDivider {
+			return s.handleDividerKey(e)
+		}
+	case input.MouseEvent:
+		return s.handleMouse(ctx, e)
+	}
+
+	switch s.focusedPane {
+	case PaneFirst:
+		return s.first != nil && HandleEventCtx(s.first, ctx, event)
+	case PaneSecond:
+		return s.second != nil && HandleEventCtx(s.second, ctx, event)
+	}
+	return false
+}
+
+// handleDividerKey moves the focused divider with the arrow keys
+func (s *SplitPane) handleDividerKey(e input.KeyEvent) bool {
+	size := s.firstSize(s.span(s.lastBounds))
+	back, forward := input.KeyLeft, input.KeyRight
+	if s.direction == layout.Vertical {
+		back, forward = input.KeyUp, input.KeyDown
+	}
+	switch e.Key {
+	case back:
+		s.moveDivider(size - 1)
+		return true
+	case forward:
+		s.moveDivider(size + 1)
+		return true
+	}
+	return false
+}
+
+// handleMouse drags the divider and passes other mouse events to the pane
+// under the pointer
+// Event coordinates are relative to the split pane's top-left corner, and
+// are translated to the pane's own corner when passed on
+func (s *SplitPane) handleMouse(ctx EventContext, e input.MouseEvent) bool {
+	local := layout.NewRect(0, 0, s.lastBounds.Z, s.lastBounds.Width, s.lastBounds.Height)
+	first, divider, second := s.PaneBounds(local)
+	pos := e.X
+	if s.direction == layout.Vertical {
+		pos = e.Y
+	}
+
+	if s.dragging {
+		if e.Button == input.MouseRelease {
+			s.dragging = false
+		} else {
+			s.moveDivider(pos)
+		}
+		return true
+	}
+	if e.Button == input.MouseLeft && divider.Contains(e.X, e.Y) {
+		s.dragging = true
+		return true
+	}
+
+	if first.Contains(e.X, e.Y) && s.first != nil {
+		return HandleEventCtx(s.first, ctx, LocalMouseEvent(e, first))
+	}
+	if second.Contains(e.X, e.Y) && s.second != nil {
+		return HandleEventCtx(s.second, ctx, LocalMouseEvent(e, second))
+	}
+	return false
+}
+
+// Children returns the two pane widgets
+func (s *SplitPane) Children() []Widget {
+	var children []Widget
+	if s.first != nil {
+		children = append(children, s.first)
+	}
+	if s.second != nil {
+		children = append(children, s.second)
+	}
+	return children
+}
+
+// Size returns the preferred size
+func (s *SplitPane) Size() layout.Size {
+	return s.combinedSize(func(w Widget) layout.Size { return w.Size() })
+}
+
+// MinSize returns the minimum size
+func (s *SplitPane) MinSize() layout.Size {
+	return s.combinedSize(func(w Widget) layout.Size { return w.MinSize() })
+}
+
+// combinedSize adds up the sizes of both panes and the divider
+func (s *SplitPane) combinedSize(size func(Widget) layout.Size) layout.Size {
+	var a, b layout.Size
+	if s.first != nil {
+		a = size(s.first)
+	}
+	if s.second != nil {
+		b = size(s.second)
+	}
+	if s.direction == layout.Horizontal {
+		return layout.NewSize(a.Width+1+b.Width, max(a.Height, b.Height))
+	}
+	return layout.NewSize(max(a.Width, b.Width), a.Height+1+b.Height)
+}
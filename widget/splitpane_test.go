@@ -0,0 +1,90 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestSplitPaneBoundsFromRatio(t *testing.T) {
+	s := NewSplitPane(layout.Horizontal, NewText("a"), NewText("b")).SetRatio(0.25)
+	first, divider, second := s.PaneBounds(layout.NewRect(10, 5, 0, 41, 8))
+
+	if want := layout.NewRect(10, 5, 0, 10, 8); first != want {
+		t.Errorf("first = %v, want %v", first, want)
+	}
+	if want := layout.NewRect(20, 5, 0, 1, 8); divider != want {
+		t.Errorf("divider = %v, want %v", divider, want)
+	}
+	if want := layout.NewRect(21, 5, 0, 30, 8); second != want {
+		t.Errorf("second = %v, want %v", second, want)
+	}
+
+	// Rendering passes the same bounds to the children
+	renderWidget(s, 41, 8)
+	if got := s.Second().(*Text).Bounds(); got != layout.NewRect(11, 0, 0, 30, 8) {
+		t.Errorf("second child rendered in %v", got)
+	}
+}
+
+// overdraw fills the whole buffer it is given, ignoring its bounds
+type overdraw struct {
+	BaseWidget
+	r rune
+}
+
+func (w *overdraw) Render(buf *screen.Buffer, bounds layout.Rect) {
+	w.bounds = bounds
+	buf.FillRect(0, 0, bounds.Z, buf.Width(), buf.Height(), screen.NewCell(w.r, terminal.DefaultStyle()))
+}
+func (w *overdraw) Size() layout.Size            { return layout.NewSize(1, 1) }
+func (w *overdraw) MinSize() layout.Size         { return layout.NewSize(1, 1) }
+func (w *overdraw) HandleEvent(input.Event) bool { return false }
+
+func TestSplitPaneClipsChildren(t *testing.T) {
+	first := &overdraw{BaseWidget: NewBaseWidget(), r: 'a'}
+	second := &overdraw{BaseWidget: NewBaseWidget(), r: 'b'}
+	s := NewSplitPane(layout.Horizontal, first, second).SetRatio(0.5)
+
+	buf := screen.NewBuffer(9, 2, screen.DefaultDepth)
+	s.Render(buf, layout.NewRect(1, 0, 0, 7, 2))
+	got := buf.ToString()
+	if want := " aaa│bbb\n aaa│bbb"; got != want {
+		t.Errorf("rendered\n%s\nwant each child kept to its pane\n%s", got, want)
+	}
+	if got := second.Bounds(); got != layout.NewRect(5, 0, 0, 3, 2) {
+		t.Errorf("second child rendered in %v, want the buffer's coordinates", got)
+	}
+}
+
+func TestSplitPaneDividerClamping(t *testing.T) {
+	s := NewSplitPane(layout.Vertical, NewText("a"), NewText("b")).SetMinSizes(3, 4)
+	bounds := layout.NewRect(0, 0, 0, 10, 21)
+
+	for _, tt := range []struct {
+		ratio float64
+		first int
+	}{
+		{0, 3},    // Held at the first pane's minimum
+		{0.5, 10}, // 20 rows less the divider, split evenly
+		{1, 16},   // Leaves the second pane its 4 rows
+	} {
+		first, _, second := s.SetRatio(tt.ratio).PaneBounds(bounds)
+		if first.Height != tt.first || second.Height != 20-tt.first {
+			t.Errorf("ratio %v: pane heights %d and %d, want %d and %d", tt.ratio, first.Height, second.Height, tt.first, 20-tt.first)
+		}
+	}
+
+	// Moving the divider with the keys stops at the minimum too
+	s.SetRatio(0)
+	s.SetFocused(true)
+	s.FocusPane(PaneDivider)
+	renderWidget(s, 10, 21)
+	s.HandleEvent(input.KeyEvent{Key: input.KeyUp})
+	if first, _, _ := s.PaneBounds(bounds); first.Height != 3 {
+		t.Errorf("Up moved the divider to %d, want it held at 3", first.Height)
+	}
+}
@@ -19,20 +19,43 @@ type Tab struct {
 }
 
 func (t *Tab) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !t.visible {
+	t.bounds = bounds
+	if !t.visible || bounds.IsEmpty() {
 		return
 	}
 	for _, widgetAndLayout := range t.widgetAndLayouts {
 		bounds := widgetAndLayout.bounds
 		widget := widgetAndLayout.widget
-		widget.Render(buf, bounds)
+		widget.Render(buf.Clip(bounds), bounds)
 	}
 }
 
+// Children returns the widgets on the tab
+func (t *Tab) Children() []Widget {
+	children := make([]Widget, len(t.widgetAndLayouts))
+	for i, widgetAndLayout := range t.widgetAndLayouts {
+		children[i] = widgetAndLayout.widget
+	}
+	return children
+}
+
+// SetVisibleRecursive sets the visibility of the tab and all its children
+func (t *Tab) SetVisibleRecursive(visible bool) {
+	setVisibleRecursive(t, visible)
+}
+
 // HandleEvent handles input events
 func (t *Tab) HandleEvent(event input.Event) bool {
+	return t.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx passes events to the focused widget along with ctx
+func (t *Tab) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if !t.visible {
+		return false
+	}
 	if t.focusedWidget >= 0 && t.focusedWidget < len(t.widgetAndLayouts) {
-		return t.widgetAndLayouts[t.focusedWidget].widget.HandleEvent(event)
+		return HandleEventCtx(t.widgetAndLayouts[t.focusedWidget].widget, ctx, event)
 	}
 	return false
 }
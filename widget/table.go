@@ -1,6 +1,11 @@
 package widget
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
@@ -9,22 +14,98 @@ import (
 
 // TableColumn defines a column in the table
 type TableColumn struct {
-	Title string
-	Width int
-	Flex  int // Flex factor for auto-sizing
-	Align layout.Alignment
+	Title   string
+	Width   int
+	Flex    int // Flex factor for auto-sizing
+	Align   layout.Alignment
+	Numeric bool // Right-aligned unless Align is set, and sorted by value
+
+	// Render, when set, draws the column's cells as styled runs, e.g. a
+	// colored status or a number with separators, given the cell text,
+	// column width and row style. Runs are aligned as a whole and clipped
+	// to the column width.
+	Render func(value string, width int, style terminal.Style) []StyledRun
+}
+
+// alignment returns how cells in the column are aligned
+func (c TableColumn) alignment() layout.Alignment {
+	if c.Numeric && c.Align == layout.AlignStart {
+		return layout.AlignEnd
+	}
+	return c.Align
+}
+
+// compare orders two cells of the column
+// Numeric columns compare by value, with cells that aren't numbers after
+// those that are
+func (c TableColumn) compare(a, b string) int {
+	if !c.Numeric {
+		return strings.Compare(a, b)
+	}
+	x, errX := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, errY := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	switch {
+	case errX != nil && errY != nil:
+		return strings.Compare(a, b)
+	case errX != nil:
+		return 1
+	case errY != nil:
+		return -1
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// RowProvider supplies table rows on demand, so only the visible rows
+// are loaded during Render
+type RowProvider interface {
+	// RowCount returns the total number of rows
+	RowCount() int
+
+	// Row returns the cells of row i
+	Row(i int) []string
 }
 
+// sliceRows is a RowProvider backed by an in-memory slice
+type sliceRows [][]string
+
+func (s sliceRows) RowCount() int      { return len(s) }
+func (s sliceRows) Row(i int) []string { return s[i] }
+
+// SelectionMode controls whether a Table selects whole rows or single cells
+type SelectionMode int
+
+const (
+	// SelectRow highlights and navigates whole rows
+	SelectRow SelectionMode = iota
+	// SelectCell highlights a single cell, navigated with Left/Right
+	SelectCell
+)
+
 // Table is a table widget with columns and rows
 type Table struct {
 	BaseWidget
+	background     background
+	zebra          zebra
 	columns        []TableColumn
-	rows           [][]string
+	rows           RowProvider
 	selectedRow    int
+	selectedCol    int
+	selectionMode  SelectionMode
+	multiSelect    bool                      // Space marks rows for OnActivateSelection
+	marked         map[string]int            // Marked rows by key, to the index each was last at
+	rowKey         func(row []string) string // Identifies marked rows across sorts
+	markedStyle    terminal.Style
+	matches        func(row, col int) []int // Rune indexes of each cell to highlight
+	matchStyle     terminal.Style
 	offset         int
 	height         int
 	showHeader     bool
 	showBorder     bool
+	wrap           bool // Up at the top and Down at the bottom wrap around
 	columnBorders  bool
 	rowBorders     bool
 	style          terminal.Style
@@ -32,39 +113,97 @@ type Table struct {
 	selectedStyle  terminal.Style
 	rowStyleFunc   func(row int, data []string) terminal.Style // Optional row style callback
 	onSelect       func(row int)
+	onSelectCell   func(row, col int)
 	onChange       func(row int)
+	onActivateSel  func(rows []int)
 	showScrollBar  bool
 	scrollBarStyle terminal.Style
+	ellipsis       bool
+	ascii          bool
+	autoFit        bool // Size columns without Width or Flex to their content
+	emptyText      string
+	wheel          wheelScroll
+	scrollbar      *Scrollbar
+	scrolled       scrollNotifier
+	clicks         clickTracker
+	frozenColumns  int // Leading columns pinned during horizontal scroll
+	colOffset      int // Number of non-frozen columns scrolled past
+	lastWidth      int // Content width from the last render
+	lastHeight     int // Inner height from the last render
 }
 
 // NewTable creates a new table widget
 func NewTable() *Table {
 	t := &Table{
 		BaseWidget:    NewBaseWidget(),
+		rows:          sliceRows(nil),
 		showHeader:    true,
 		height:        10,
 		style:         terminal.DefaultStyle(),
 		headerStyle:   terminal.DefaultStyle().WithBold(),
 		selectedStyle: terminal.DefaultStyle().WithReverse(),
+		markedStyle:   terminal.DefaultStyle().WithFG(terminal.ColorYellow).WithBold(),
+		matchStyle:    terminal.DefaultStyle().WithFG(terminal.ColorYellow).WithBold(),
+		marked:        make(map[string]int),
 		columnBorders: true,
-		rowBorders: true,
+		wheel:         newWheelScroll(),
+		scrollbar:     NewScrollbar(layout.Vertical),
+		clicks:        newClickTracker(),
 	}
 	t.SetInteractive(true)
 	return t
 }
 
-// SetColumnBorders enables or disables column borders
+// SetColumnBorders enables or disables the vertical │ separators between columns
 func (t *Table) SetColumnBorders(show bool) *Table {
 	t.columnBorders = show
 	return t
 }
 
-// SetRowBorders enables or disables row borders
+// SetRowBorders enables or disables the horizontal ─ separators between data rows
 func (t *Table) SetRowBorders(show bool) *Table {
 	t.rowBorders = show
 	return t
 }
 
+// SetAutoFit sizes columns with neither a Width nor a Flex to their
+// widest cell or title, within the available space; flex columns share
+// what is left
+// With a RowProvider only the rows in view are measured, so widths can
+// change while scrolling.
+func (t *Table) SetAutoFit(autoFit bool) *Table {
+	t.autoFit = autoFit
+	return t
+}
+
+// SetAscii sets whether borders, grid lines and the scroll bar are drawn
+// with plain ASCII, regardless of screen.AsciiOnly
+func (t *Table) SetAscii(ascii bool) *Table {
+	t.ascii = ascii
+	return t
+}
+
+// borders returns the glyphs for the table's borders and grid lines
+func (t *Table) borders() screen.Borders {
+	if t.ascii {
+		return screen.AsciiBorders
+	}
+	return screen.DefaultBorders()
+}
+
+// SetEmptyText sets the placeholder shown below the header when the table
+// has no rows
+func (t *Table) SetEmptyText(text string) *Table {
+	t.emptyText = text
+	return t
+}
+
+// SetEllipsis enables ending truncated cell text with an ellipsis
+func (t *Table) SetEllipsis(ellipsis bool) *Table {
+	t.ellipsis = ellipsis
+	return t
+}
+
 // SetRowStyleFunc sets a callback to provide custom styles for each row
 // The function receives the row index and row data, and returns the style to use
 func (t *Table) SetRowStyleFunc(fn func(row int, data []string) terminal.Style) *Table {
@@ -72,20 +211,50 @@ func (t *Table) SetRowStyleFunc(fn func(row int, data []string) terminal.Style)
 	return t
 }
 
+// SetMatches sets a callback giving the rune indexes to highlight in the
+// cell at row and col, ascending, e.g. from text.FuzzyMatch, or nil for
+// none
+// Cells drawn by a column's Render function aren't highlighted.
+func (t *Table) SetMatches(fn func(row, col int) []int) *Table {
+	t.matches = fn
+	return t
+}
+
+// SetMatchStyle sets the style of the runes highlighted by SetMatches
+// On the selection they are drawn bold and underlined in the selected
+// style instead, to stay readable on its background
+func (t *Table) SetMatchStyle(style terminal.Style) *Table {
+	t.matchStyle = style
+	return t
+}
+
 // SetShowScrollBar enables or disables the scroll bar indicator
 func (t *Table) SetShowScrollBar(show bool) *Table {
 	t.showScrollBar = show
 	return t
 }
 
+// SetWheelStep sets how many rows one mouse wheel notch scrolls
+func (t *Table) SetWheelStep(lines int) *Table {
+	t.wheel.step = max(1, lines)
+	return t
+}
+
+// SetWheelMovesCursor sets whether the mouse wheel moves the selection
+// along with the view, rather than only scrolling it
+func (t *Table) SetWheelMovesCursor(move bool) *Table {
+	t.wheel.moveCursor = move
+	return t
+}
+
 // GetScrollInfo returns current scroll position info: (firstVisible, lastVisible, total)
 func (t *Table) GetScrollInfo() (int, int, int) {
-	total := len(t.rows)
+	total := t.rowCount()
 	if total == 0 {
 		return 0, 0, 0
 	}
 	first := t.offset + 1 // 1-indexed for display
-	last := t.offset + t.height
+	last := t.offset + t.visibleRowCount()
 	if last > total {
 		last = total
 	}
@@ -95,14 +264,168 @@ func (t *Table) GetScrollInfo() (int, int, int) {
 // SetColumns sets the table columns
 func (t *Table) SetColumns(columns []TableColumn) *Table {
 	t.columns = columns
+	t.SetColumnOffset(t.colOffset)
+	t.selectedCol = max(0, min(t.selectedCol, len(columns)-1))
+	return t
+}
+
+// SetFrozenColumns pins the first n columns in place while the remaining
+// columns scroll horizontally with Left/Right
+func (t *Table) SetFrozenColumns(n int) *Table {
+	if n < 0 {
+		n = 0
+	}
+	t.frozenColumns = n
+	t.SetColumnOffset(t.colOffset)
+	return t
+}
+
+// SetColumnOffset sets how many non-frozen columns are scrolled past
+func (t *Table) SetColumnOffset(offset int) *Table {
+	t.colOffset = max(0, min(offset, t.maxColumnOffset()))
+	return t
+}
+
+// ColumnOffset returns how many non-frozen columns are scrolled past
+func (t *Table) ColumnOffset() int {
+	return t.colOffset
+}
+
+// maxColumnOffset returns the largest column offset, keeping at least one
+// scrolling column visible
+func (t *Table) maxColumnOffset() int {
+	return max(0, len(t.columns)-t.frozenColumns-1)
+}
+
+// visibleColumns returns the indices of the frozen columns followed by the
+// scrolled non-frozen columns
+func (t *Table) visibleColumns() []int {
+	frozen := min(t.frozenColumns, len(t.columns))
+	cols := make([]int, 0, len(t.columns))
+	for i := 0; i < frozen; i++ {
+		cols = append(cols, i)
+	}
+	for i := frozen + t.colOffset; i < len(t.columns); i++ {
+		cols = append(cols, i)
+	}
+	return cols
+}
+
+// SetSelectionMode sets whether rows or single cells are selected
+func (t *Table) SetSelectionMode(mode SelectionMode) *Table {
+	t.selectionMode = mode
+	return t
+}
+
+// SelectedCell returns the selected row and column
+func (t *Table) SelectedCell() (int, int) {
+	return t.selectedRow, t.selectedCol
+}
+
+// SelectColumn sets the selected column (used in cell selection mode)
+func (t *Table) SelectColumn(col int) *Table {
+	t.selectedCol = max(0, min(col, len(t.columns)-1))
+	t.ensureColumnVisible()
 	return t
 }
 
+// ensureColumnVisible scrolls horizontally so the selected column is shown
+func (t *Table) ensureColumnVisible() {
+	if t.selectedCol < t.frozenColumns {
+		return
+	}
+	scrolled := t.selectedCol - t.frozenColumns
+	if scrolled < t.colOffset {
+		t.SetColumnOffset(scrolled)
+		return
+	}
+	for t.colOffset < scrolled && t.lastWidth > 0 && !t.columnShown(t.selectedCol) {
+		t.colOffset++
+	}
+}
+
+// columnShown reports whether a column is drawn at its full width
+// at the last rendered width
+func (t *Table) columnShown(col int) bool {
+	cols := t.visibleColumns()
+	widths := t.calculateColumnWidths(cols, t.lastWidth)
+	full := append([]int(nil), widths...)
+	fitted, _ := t.fitColumns(widths, t.lastWidth)
+	for i, c := range cols {
+		if c == col {
+			return i < len(fitted) && fitted[i] == full[i]
+		}
+	}
+	return false
+}
+
 // SetRows sets the table rows
 func (t *Table) SetRows(rows [][]string) *Table {
-	t.rows = rows
-	if t.selectedRow >= len(rows) {
-		t.selectedRow = len(rows) - 1
+	return t.SetRowProvider(sliceRows(rows))
+}
+
+// SortBy sorts the rows by column col, keeping the order of equal rows
+// Only rows set with SetRows can be sorted; other providers are left as is.
+// Marked rows stay marked.
+func (t *Table) SortBy(col int, descending bool) *Table {
+	rows, ok := t.rows.(sliceRows)
+	if !ok || col < 0 || col >= len(t.columns) {
+		return t
+	}
+	column := t.columns[col]
+	cell := func(row []string) string {
+		if col < len(row) {
+			return row[col]
+		}
+		return ""
+	}
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if descending {
+			return column.compare(cell(rows[b]), cell(rows[a]))
+		}
+		return column.compare(cell(rows[a]), cell(rows[b]))
+	})
+
+	sorted := make(sliceRows, len(rows))
+	moved := make([]int, len(rows)) // New index of each old row
+	for i, old := range order {
+		sorted[i] = rows[old]
+		moved[old] = i
+	}
+	copy(rows, sorted)
+	t.remapMarks(moved)
+	return t
+}
+
+// remapMarks moves the marks to the rows' new indexes after a sort
+func (t *Table) remapMarks(moved []int) {
+	marked := make(map[string]int, len(t.marked))
+	for key, row := range t.marked {
+		if row >= len(moved) {
+			continue
+		}
+		row = moved[row]
+		if t.rowKey == nil {
+			key = strconv.Itoa(row)
+		}
+		marked[key] = row
+	}
+	t.marked = marked
+}
+
+// SetRowProvider sets the source the table loads its rows from
+func (t *Table) SetRowProvider(provider RowProvider) *Table {
+	if provider == nil {
+		provider = sliceRows(nil)
+	}
+	t.rows = provider
+	count := provider.RowCount()
+	if t.selectedRow >= count {
+		t.selectedRow = count - 1
 	}
 	if t.selectedRow < 0 {
 		t.selectedRow = 0
@@ -111,9 +434,27 @@ func (t *Table) SetRows(rows [][]string) *Table {
 	return t
 }
 
+// RowProvider returns the source the table loads its rows from
+func (t *Table) RowProvider() RowProvider {
+	return t.rows
+}
+
 // Rows returns the table rows
+// Rows from a provider other than SetRows are all loaded into a new slice
 func (t *Table) Rows() [][]string {
-	return t.rows
+	if rows, ok := t.rows.(sliceRows); ok {
+		return rows
+	}
+	rows := make([][]string, t.rowCount())
+	for i := range rows {
+		rows[i] = t.rows.Row(i)
+	}
+	return rows
+}
+
+// rowCount returns the total number of rows
+func (t *Table) rowCount() int {
+	return t.rows.RowCount()
 }
 
 // SelectedRow returns the selected row index
@@ -126,8 +467,8 @@ func (t *Table) SelectRow(row int) *Table {
 	if row < 0 {
 		row = 0
 	}
-	if row >= len(t.rows) {
-		row = len(t.rows) - 1
+	if row >= t.rowCount() {
+		row = t.rowCount() - 1
 	}
 	t.selectedRow = row
 	t.ensureVisible()
@@ -152,6 +493,13 @@ func (t *Table) SetShowBorder(show bool) *Table {
 	return t
 }
 
+// SetWrapSelection makes Up at the first row move to the last and Down at
+// the last row move to the first
+func (t *Table) SetWrapSelection(wrap bool) *Table {
+	t.wrap = wrap
+	return t
+}
+
 // SetStyle sets the normal style
 func (t *Table) SetStyle(style terminal.Style) *Table {
 	t.style = style
@@ -170,77 +518,284 @@ func (t *Table) SetSelectedStyle(style terminal.Style) *Table {
 	return t
 }
 
-// OnSelect sets the callback for Enter key
-func (t *Table) OnSelect(fn func(row int)) *Table {
+// OnActivate sets the callback for Enter or a double-click on a row
+func (t *Table) OnActivate(fn func(row int)) *Table {
 	t.onSelect = fn
 	return t
 }
 
-// OnChange sets the callback for selection change
-func (t *Table) OnChange(fn func(row int)) *Table {
+// OnSelect is the older name of OnActivate
+func (t *Table) OnSelect(fn func(row int)) *Table {
+	return t.OnActivate(fn)
+}
+
+// SetMultiSelect sets whether Space marks rows, so Enter can act on
+// several at once through OnActivateSelection
+func (t *Table) SetMultiSelect(multi bool) *Table {
+	t.multiSelect = multi
+	if !multi {
+		t.ClearSelection()
+	}
+	return t
+}
+
+// SetRowKey sets how marked rows are identified, so marks follow their
+// rows when sorted or reloaded
+// Without it rows are identified by index.
+func (t *Table) SetRowKey(fn func(row []string) string) *Table {
+	t.rowKey = fn
+	t.ClearSelection()
+	return t
+}
+
+// SetMarkedStyle sets the style of marked rows
+func (t *Table) SetMarkedStyle(style terminal.Style) *Table {
+	t.markedStyle = style
+	return t
+}
+
+// OnActivateSelection sets the callback for Enter in multi-select mode,
+// given the marked rows, or the selected row if none are marked
+func (t *Table) OnActivateSelection(fn func(rows []int)) *Table {
+	t.onActivateSel = fn
+	return t
+}
+
+// SelectedRows returns the indexes of the marked rows, ascending
+// Indexes follow SortBy; rows marked before SetRows or SetRowProvider keep
+// the index they had, so read SelectedKeys after reloading.
+func (t *Table) SelectedRows() []int {
+	var rows []int
+	for _, row := range t.marked {
+		rows = append(rows, row)
+	}
+	slices.Sort(rows)
+	return rows
+}
+
+// SelectedKeys returns the row keys of the marked rows, sorted
+// Without a row key set the keys are the rows' indexes.
+func (t *Table) SelectedKeys() []string {
+	var keys []string
+	for key := range t.marked {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// IsRowSelected returns true if row is marked
+func (t *Table) IsRowSelected(row int) bool {
+	if len(t.marked) == 0 {
+		return false
+	}
+	_, ok := t.marked[t.markKey(row)]
+	return ok
+}
+
+// ToggleRow marks row, or unmarks it if marked
+func (t *Table) ToggleRow(row int) *Table {
+	if row < 0 || row >= t.rowCount() {
+		return t
+	}
+	key := t.markKey(row)
+	if _, ok := t.marked[key]; ok {
+		delete(t.marked, key)
+	} else {
+		t.marked[key] = row
+	}
+	return t
+}
+
+// ClearSelection unmarks all rows
+func (t *Table) ClearSelection() *Table {
+	clear(t.marked)
+	return t
+}
+
+// markKey returns the key row is marked under
+func (t *Table) markKey(row int) string {
+	if t.rowKey != nil {
+		return t.rowKey(t.rows.Row(row))
+	}
+	return strconv.Itoa(row)
+}
+
+// OnSelectCell sets the callback for Enter key in cell selection mode
+func (t *Table) OnSelectCell(fn func(row, col int)) *Table {
+	t.onSelectCell = fn
+	return t
+}
+
+// OnHighlight sets the callback for the selection moving to another row
+func (t *Table) OnHighlight(fn func(row int)) *Table {
 	t.onChange = fn
 	return t
 }
 
+// OnChange is the older name of OnHighlight
+func (t *Table) OnChange(fn func(row int)) *Table {
+	return t.OnHighlight(fn)
+}
+
+// OnScroll sets the callback for the view scrolling, with the index of the
+// first row shown, how many rows fit and how many there are
+// Loading more rows once offset+visible nears total gives infinite
+// scrolling.
+func (t *Table) OnScroll(fn func(offset, visible, total int)) *Table {
+	t.scrolled.fn = fn
+	return t
+}
+
+// SetZebra stripes rows, alternating between the even and odd styles by
+// row index; the row style func and the selection still take precedence
+func (t *Table) SetZebra(even, odd terminal.Style) *Table {
+	t.zebra.set(even, odd)
+	return t
+}
+
+// SetBackground fills the whole bounds with spaces in style before drawing
+// the content; by default only the rows with content are drawn
+func (t *Table) SetBackground(style terminal.Style) *Table {
+	t.background.set(style)
+	return t
+}
+
 // Render draws the table
 func (t *Table) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !t.visible || len(t.columns) == 0 {
+	t.bounds = bounds
+	if !t.visible || bounds.IsEmpty() || len(t.columns) == 0 {
 		return
 	}
+	t.background.fill(buf, bounds)
 
 	innerBounds := bounds
 	if t.showBorder {
-		buf.DrawBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, t.style)
+		buf.DrawBorderedBox(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, t.borders(), t.style)
 		innerBounds = bounds.Inset(1, 1, 1, 1)
 	}
+	if innerBounds.IsEmpty() {
+		return
+	}
+
+	// Reserve space for scroll bar if enabled
+	contentWidth := innerBounds.Width
+	scrollBarX := innerBounds.X + innerBounds.Width - 1
+	if t.showScrollBar {
+		contentWidth = max(0, contentWidth-2) // Reserve space for scroll bar + separator
+		scrollBarX = innerBounds.X + contentWidth + 1
+	}
 
 	// Calculate column widths
-	colWidths := t.calculateColumnWidths(innerBounds.Width)
+	t.lastWidth = contentWidth
+	cols := t.visibleColumns()
+	colWidths, _ := t.fitColumns(t.calculateColumnWidths(cols, contentWidth), contentWidth)
+	cols = cols[:len(colWidths)]
 
 	y := innerBounds.Y
 
 	// Draw header
 	if t.showHeader {
-		t.drawRow(buf, innerBounds.X, y, innerBounds.Z, colWidths, t.getColumnTitles(), t.headerStyle)
-		y++
-		// Draw separator
-		t.drawSeparator(buf, innerBounds.X, y, innerBounds.Z, colWidths)
+		t.drawRow(buf, innerBounds.X, y, innerBounds.Z, cols, colWidths, t.getColumnTitles(), t.headerStyle, -1, -1)
 		y++
+		if t.hasHeaderSeparator() && y < innerBounds.Bottom() {
+			t.drawSeparator(buf, innerBounds.X, y, innerBounds.Z, colWidths)
+			y++
+		}
 	}
 
-	// Draw rows
-	visibleHeight := innerBounds.Height
-	if t.showHeader {
-		visibleHeight -= 2
+	// Draw rows, keeping the selection visible if the viewport changed
+	if innerBounds.Height != t.lastHeight {
+		t.lastHeight = innerBounds.Height
+		t.ensureVisible()
 	}
+	visibleHeight := max(0, innerBounds.Height-t.headerLines())
+	visibleRows := t.rowsInLines(visibleHeight)
 
-	// Reserve space for scroll bar if enabled
-	contentWidth := innerBounds.Width
-	scrollBarX := innerBounds.X + innerBounds.Width - 1
-	if t.showScrollBar {
-		contentWidth -= 2 // Reserve space for scroll bar + separator
-		scrollBarX = innerBounds.X + contentWidth + 1
+	total := t.rowCount()
+	if total == 0 {
+		drawEmptyText(buf, layout.NewRect(innerBounds.X, y, innerBounds.Z, contentWidth, visibleHeight), t.emptyText, t.style)
+		return
 	}
 
-	for i := 0; i < visibleHeight && i+t.offset < len(t.rows); i++ {
+	for i := 0; i < visibleRows && i+t.offset < total; i++ {
 		rowIndex := i + t.offset
-		rowData := t.rows[rowIndex]
-		
+		rowData := t.rows.Row(rowIndex)
+
 		// Determine row style
-		style := t.style
+		style := t.zebra.style(rowIndex, t.style)
 		if t.rowStyleFunc != nil {
 			style = t.rowStyleFunc(rowIndex, rowData)
 		}
+		marked := len(t.marked) > 0 && t.IsRowSelected(rowIndex)
+		if marked {
+			style = t.markedStyle
+		}
+		selectedCol := -1
 		if rowIndex == t.selectedRow && t.focused {
-			style = t.selectedStyle
+			if t.selectionMode == SelectCell {
+				selectedCol = t.selectedCol
+			} else if marked {
+				style = t.selectedStyle.WithBold()
+			} else {
+				style = t.selectedStyle
+			}
+		}
+		rowY := y + i*t.rowStride()
+		t.drawRow(buf, innerBounds.X, rowY, innerBounds.Z, cols, colWidths, rowData, style, selectedCol, rowIndex)
+
+		// Draw row border below every row but the last visible one
+		if t.rowBorders && i < visibleRows-1 && rowIndex < total-1 {
+			t.drawSeparator(buf, innerBounds.X, rowY+1, innerBounds.Z, colWidths)
+		}
+	}
+
+	// Draw scroll bar if enabled; it draws nothing while all rows fit
+	if t.showScrollBar && scrollBarX < innerBounds.Right() {
+		track, thumb := '░', '█'
+		if t.ascii {
+			track, thumb = '|', '#'
 		}
-		t.drawRow(buf, innerBounds.X, y+i, innerBounds.Z, colWidths, rowData, style)
+		t.scrollbar.SetChars(track, thumb).SetStyles(t.style.WithDim(), t.style.WithReverse())
+		t.scrollbar.Render(buf, layout.NewRect(scrollBarX, y, innerBounds.Z, 1, visibleHeight), total, visibleRows, t.offset)
 	}
+}
+
+// hasHeaderSeparator returns whether a separator line is drawn under the header
+// A table without column or row borders is drawn grid-less
+func (t *Table) hasHeaderSeparator() bool {
+	return t.columnBorders || t.rowBorders
+}
 
-	// Draw scroll bar if enabled and needed
-	if t.showScrollBar && len(t.rows) > visibleHeight {
-		t.drawScrollBar(buf, scrollBarX, y, innerBounds.Z, visibleHeight, len(t.rows), t.offset)
+// headerLines returns the number of lines taken by the header and its separator
+func (t *Table) headerLines() int {
+	if !t.showHeader {
+		return 0
+	}
+	if t.hasHeaderSeparator() {
+		return 2
 	}
+	return 1
+}
+
+// rowStride returns the number of lines each data row takes
+func (t *Table) rowStride() int {
+	if t.rowBorders {
+		return 2
+	}
+	return 1
+}
+
+// rowsInLines returns how many data rows fit in the given number of lines
+func (t *Table) rowsInLines(lines int) int {
+	if lines <= 0 {
+		return 0
+	}
+	if t.rowBorders {
+		// The last row doesn't need a border below it
+		return (lines + 1) / 2
+	}
+	return lines
 }
 
 func (t *Table) getColumnTitles() []string {
@@ -251,16 +806,21 @@ func (t *Table) getColumnTitles() []string {
 	return titles
 }
 
-func (t *Table) calculateColumnWidths(totalWidth int) []int {
-	widths := make([]int, len(t.columns))
+// calculateColumnWidths calculates the widths of the given columns
+func (t *Table) calculateColumnWidths(cols []int, totalWidth int) []int {
+	widths := make([]int, len(cols))
 	flexTotal := 0
 	fixedWidth := 0
 
 	// First pass: calculate fixed widths and flex total
-	for i, col := range t.columns {
+	for i, c := range cols {
+		col := t.columns[c]
 		if col.Width > 0 {
 			widths[i] = col.Width
 			fixedWidth += col.Width
+		} else if t.autoFits(col) {
+			widths[i] = min(t.contentWidth(c), max(0, totalWidth-fixedWidth))
+			fixedWidth += widths[i]
 		} else {
 			flex := col.Flex
 			if flex == 0 {
@@ -271,13 +831,17 @@ func (t *Table) calculateColumnWidths(totalWidth int) []int {
 	}
 
 	// Add separators
-	separatorWidth := len(t.columns) - 1
+	separatorWidth := 0
+	if t.columnBorders {
+		separatorWidth = len(cols) - 1
+	}
 	remaining := totalWidth - fixedWidth - separatorWidth
 
 	// Second pass: distribute remaining width
 	if flexTotal > 0 && remaining > 0 {
-		for i, col := range t.columns {
-			if col.Width == 0 {
+		for i, c := range cols {
+			col := t.columns[c]
+			if col.Width == 0 && !t.autoFits(col) {
 				flex := col.Flex
 				if flex == 0 {
 					flex = 1
@@ -290,113 +854,198 @@ func (t *Table) calculateColumnWidths(totalWidth int) []int {
 	return widths
 }
 
-func (t *Table) drawRow(buf *screen.Buffer, x, y, z int, widths []int, cells []string, style terminal.Style) {
+// autoFits returns whether col is sized to its content
+func (t *Table) autoFits(col TableColumn) bool {
+	return t.autoFit && col.Width == 0 && col.Flex == 0
+}
+
+// contentWidth returns the width of the widest cell in column c, or its
+// title if shown, measuring every row of an in-memory table and only the
+// rows in view of a RowProvider
+func (t *Table) contentWidth(c int) int {
+	width := 0
+	if t.showHeader {
+		width = screen.DisplayWidth(t.columns[c].Title)
+	}
+	from, to := 0, t.rowCount()
+	if _, ok := t.rows.(sliceRows); !ok {
+		from = t.offset
+		to = min(to, t.offset+t.visibleRowCount())
+	}
+	for i := from; i < to; i++ {
+		if row := t.rows.Row(i); c < len(row) {
+			width = max(width, screen.DisplayWidth(row[c]))
+		}
+	}
+	return width
+}
+
+// fitColumns drops or narrows trailing columns that don't fit in totalWidth
+// Returns the fitted widths and whether every column fit completely
+func (t *Table) fitColumns(widths []int, totalWidth int) ([]int, bool) {
+	used := 0
+	for i, width := range widths {
+		if i > 0 && t.columnBorders {
+			used++
+		}
+		if used+width > totalWidth {
+			available := totalWidth - used
+			if available <= 0 {
+				return widths[:i], false
+			}
+			fitted := append(widths[:i:i], available)
+			return fitted, false
+		}
+		used += width
+	}
+	return widths, true
+}
+
+// columnsOverflow returns whether some scrolling columns lie past the right
+// edge of the last rendered width
+func (t *Table) columnsOverflow() bool {
+	if t.lastWidth <= 0 {
+		return true
+	}
+	cols := t.visibleColumns()
+	_, complete := t.fitColumns(t.calculateColumnWidths(cols, t.lastWidth), t.lastWidth)
+	return !complete
+}
+
+// scrollColumns scrolls the non-frozen columns horizontally
+// Returns true if the column offset changed
+func (t *Table) scrollColumns(delta int) bool {
+	if delta > 0 && !t.columnsOverflow() {
+		return false
+	}
+	previous := t.colOffset
+	t.SetColumnOffset(t.colOffset + delta)
+	return t.colOffset != previous
+}
+
+// drawRow draws the cells of the given columns of data row row, or of the
+// header if row is -1
+// The cell in selectedCol (if not -1) is drawn in the selected style
+// Column renderers and match highlighting apply to data rows only.
+func (t *Table) drawRow(buf *screen.Buffer, x, y, z int, cols []int, widths []int, cells []string, rowStyle terminal.Style, selectedCol int, row int) {
+	data := row >= 0
 	currentX := x
 	for i, width := range widths {
+		col := cols[i]
+		style := rowStyle
+		if col == selectedCol {
+			style = t.selectedStyle
+		}
+
 		// Clear cell
 		for dx := 0; dx < width; dx++ {
 			buf.Set(currentX+dx, y, z, screen.NewCell(' ', style))
 		}
 
 		// Draw cell content
-		if i < len(cells) {
-			text := cells[i]
-			if len(text) > width {
-				text = text[:width]
-			}
+		if render := t.columns[col].Render; data && render != nil && col < len(cells) {
+			drawRuns(buf, currentX, y, z, render(cells[col], width, style), width, t.columns[col].alignment())
+		} else if col < len(cells) {
+			text := screen.Truncate(cells[col], width, t.ellipsis)
 
 			// Apply alignment
-			offset := 0
-			if i < len(t.columns) {
-				offset = layout.Align(len(text), width, t.columns[i].Align)
-			}
-
-			buf.DrawString(currentX+offset, y, z, text, style)
-		}
-
-		currentX += width
+			offset := layout.Align(screen.DisplayWidth(text), width, t.columns[col].alignment())
 
-		// Draw separator
-		separator := '*'
-		if t.rowBorders {
-			separator = '|'
-		}
-		if t.columnBorders {
-			if i < len(widths)-1 {
-				buf.Set(currentX, y, z, screen.NewCell(separator, style))
-				currentX++
+			if matches := t.cellMatches(row, col, text != cells[col], text); len(matches) > 0 {
+				buf.DrawStringHighlighted(currentX+offset, y, z, text, style, t.cellMatchStyle(row, col, style), matches, width)
+			} else {
+				buf.DrawString(currentX+offset, y, z, text, style)
 			}
 		}
-	}
-}
 
-func (t *Table) drawSeparator(buf *screen.Buffer, x, y, z int, widths []int) {
-	currentX := x
-	separator := '*'
-	if t.rowBorders {
-		separator = '-'
-	}
-	for i, width := range widths {
-		for dx := 0; dx < width; dx++ {
-			buf.Set(currentX+dx, y, z, screen.NewCell(separator, t.style))
-		}
 		currentX += width
 
-		if i < len(widths)-1 {
-			buf.Set(currentX, y, z, screen.NewCell(separator, t.style))
+		// Draw column border
+		if t.columnBorders && i < len(widths)-1 {
+			buf.Set(currentX, y, z, screen.NewCell(t.borders().Vertical, rowStyle))
 			currentX++
 		}
 	}
 }
 
-// drawScrollBar draws a vertical scroll bar indicator
-func (t *Table) drawScrollBar(buf *screen.Buffer, x, y, z, height, totalRows, offset int) {
-	if height <= 0 || totalRows <= 0 {
-		return
+// cellMatches returns the rune indexes to highlight in text, the cell at
+// row and col drawn truncated if truncated is true
+func (t *Table) cellMatches(row, col int, truncated bool, text string) []int {
+	if row < 0 || t.matches == nil {
+		return nil
 	}
-
-	scrollStyle := t.style.WithDim()
-	thumbStyle := t.style.WithReverse()
-
-	// Calculate thumb size and position
-	thumbSize := height * height / totalRows
-	if thumbSize < 1 {
-		thumbSize = 1
+	matches := t.matches(row, col)
+	if truncated && t.ellipsis && len(matches) > 0 {
+		// Don't highlight the ellipsis
+		last := len([]rune(text)) - 1
+		matches = slices.DeleteFunc(slices.Clone(matches), func(i int) bool { return i >= last })
 	}
-	if thumbSize > height {
-		thumbSize = height
+	return matches
+}
+
+// cellMatchStyle returns the style of highlighted runes in the cell at row
+// and col, drawn in style
+func (t *Table) cellMatchStyle(row, col int, style terminal.Style) terminal.Style {
+	selected := row == t.selectedRow && t.focused && (t.selectionMode == SelectRow || col == t.selectedCol)
+	if selected || t.IsRowSelected(row) {
+		return style.WithBold().WithUnderline()
 	}
+	return t.matchStyle
+}
 
-	// Calculate thumb position
-	scrollRange := totalRows - height
-	if scrollRange <= 0 {
-		scrollRange = 1
+// drawRuns draws runs aligned within width cells, clipping at the end
+func drawRuns(buf *screen.Buffer, x, y, z int, runs []StyledRun, width int, align layout.Alignment) {
+	total := 0
+	for _, run := range runs {
+		total += screen.DisplayWidth(run.Text)
 	}
-	thumbPos := (offset * (height - thumbSize)) / scrollRange
-	if thumbPos < 0 {
-		thumbPos = 0
+	used := 0
+	if total < width {
+		used = layout.Align(total, width, align)
 	}
-	if thumbPos+thumbSize > height {
-		thumbPos = height - thumbSize
+	for _, run := range runs {
+		if used >= width {
+			return
+		}
+		buf.DrawStringClipped(x+used, y, z, run.Text, run.Style, width-used)
+		used += screen.DisplayWidth(run.Text)
 	}
+}
 
-	// Draw the scroll bar track and thumb
-	for i := 0; i < height; i++ {
-		var ch rune
-		var style terminal.Style
-		if i >= thumbPos && i < thumbPos+thumbSize {
-			ch = '█' // Thumb
-			style = thumbStyle
-		} else {
-			ch = '░' // Track
-			style = scrollStyle
+// drawSeparator draws a horizontal line across the columns, with junctions
+// where it crosses column borders
+func (t *Table) drawSeparator(buf *screen.Buffer, x, y, z int, widths []int) {
+	borders := t.borders()
+	currentX := x
+	for i, width := range widths {
+		for dx := 0; dx < width; dx++ {
+			buf.Set(currentX+dx, y, z, screen.NewCell(borders.Horizontal, t.style))
+		}
+		currentX += width
+
+		if t.columnBorders && i < len(widths)-1 {
+			buf.Set(currentX, y, z, screen.NewCell(borders.Cross, t.style))
+			currentX++
 		}
-		buf.Set(x, y+i, z, screen.NewCell(ch, style))
 	}
 }
 
 // HandleEvent handles input events
 func (t *Table) HandleEvent(event input.Event) bool {
+	if e, ok := event.(input.MouseEvent); ok {
+		if offset, ok := t.scrollbar.Drag(e, t.bounds); ok {
+			t.offset = offset
+			t.notifyScroll()
+			return true
+		}
+	}
+	if lines := t.wheel.delta(event, t.bounds); lines != 0 {
+		t.scrollBy(lines)
+		return true
+	}
+	if e, ok := leftPress(event); ok {
+		return t.click(e)
+	}
 	if !t.focused {
 		return false
 	}
@@ -413,6 +1062,18 @@ func (t *Table) HandleEvent(event input.Event) bool {
 	case input.KeyDown:
 		t.moveDown()
 		return true
+	case input.KeyLeft:
+		if t.selectionMode == SelectCell {
+			t.SelectColumn(t.selectedCol - 1)
+			return true
+		}
+		return t.scrollColumns(-1)
+	case input.KeyRight:
+		if t.selectionMode == SelectCell {
+			t.SelectColumn(t.selectedCol + 1)
+			return true
+		}
+		return t.scrollColumns(1)
 	case input.KeyPageUp:
 		t.pageUp()
 		return true
@@ -424,37 +1085,86 @@ func (t *Table) HandleEvent(event input.Event) bool {
 		t.notifyChange()
 		return true
 	case input.KeyEnd:
-		t.SelectRow(len(t.rows) - 1)
+		t.SelectRow(t.rowCount() - 1)
 		t.notifyChange()
 		return true
 	case input.KeyEnter:
-		if t.onSelect != nil {
-			t.onSelect(t.selectedRow)
-		}
+		t.activate()
 		return true
+	case input.KeyRune:
+		if keyEvent.Rune == ' ' && keyEvent.Modifier == input.ModNone && t.multiSelect {
+			t.ToggleRow(t.selectedRow)
+			return true
+		}
 	}
 
 	return false
 }
 
+// HelpEntries returns the table's keys
+func (t *Table) HelpEntries() []HelpEntry {
+	entries := []HelpEntry{
+		{Keys: "↑/↓", Description: "Move"},
+		{Keys: "←/→", Description: "Scroll columns"},
+		{Keys: "PgUp/PgDn", Description: "Page"},
+		{Keys: "Home/End", Description: "First/last row"},
+		{Keys: "Enter", Description: "Select row"},
+	}
+	if t.selectionMode == SelectCell {
+		entries[1].Description = "Move between cells"
+	}
+	if t.multiSelect {
+		entries = append(entries, HelpEntry{Keys: "Space", Description: "Mark row"})
+	}
+	return entries
+}
+
+// activate reports the selected row, and cell in cell selection mode
+// In multi-select mode the marked rows are reported instead, if there is
+// a callback for them.
+func (t *Table) activate() {
+	if t.multiSelect && t.onActivateSel != nil {
+		rows := t.SelectedRows()
+		if len(rows) == 0 && t.selectedRow < t.rowCount() {
+			rows = []int{t.selectedRow}
+		}
+		t.onActivateSel(rows)
+		return
+	}
+	if t.onSelect != nil {
+		t.onSelect(t.selectedRow)
+	}
+	if t.selectionMode == SelectCell && t.onSelectCell != nil {
+		t.onSelectCell(t.selectedRow, t.selectedCol)
+	}
+}
+
 func (t *Table) moveUp() {
 	if t.selectedRow > 0 {
 		t.selectedRow--
-		t.ensureVisible()
-		t.notifyChange()
+	} else if t.wrap && t.rowCount() > 1 {
+		t.selectedRow = t.rowCount() - 1
+	} else {
+		return
 	}
+	t.ensureVisible()
+	t.notifyChange()
 }
 
 func (t *Table) moveDown() {
-	if t.selectedRow < len(t.rows)-1 {
+	if t.selectedRow < t.rowCount()-1 {
 		t.selectedRow++
-		t.ensureVisible()
-		t.notifyChange()
+	} else if t.wrap && t.rowCount() > 1 {
+		t.selectedRow = 0
+	} else {
+		return
 	}
+	t.ensureVisible()
+	t.notifyChange()
 }
 
 func (t *Table) pageUp() {
-	t.selectedRow -= t.height
+	t.selectedRow -= t.visibleRowCount()
 	if t.selectedRow < 0 {
 		t.selectedRow = 0
 	}
@@ -463,25 +1173,98 @@ func (t *Table) pageUp() {
 }
 
 func (t *Table) pageDown() {
-	t.selectedRow += t.height
-	if t.selectedRow >= len(t.rows) {
-		t.selectedRow = len(t.rows) - 1
+	t.selectedRow += t.visibleRowCount()
+	if t.selectedRow >= t.rowCount() {
+		t.selectedRow = t.rowCount() - 1
 	}
 	t.ensureVisible()
 	t.notifyChange()
 }
 
-func (t *Table) ensureVisible() {
-	visibleRows := t.height
-	if t.showHeader {
-		visibleRows -= 2
+// visibleRowCount returns how many rows fit in the viewport: the height
+// of the last render, or the configured height before the first render
+func (t *Table) visibleRowCount() int {
+	height := t.height
+	if t.lastHeight > 0 {
+		height = t.lastHeight
+	}
+	return max(1, t.rowsInLines(height-t.headerLines()))
+}
+
+// click selects the row under a left press, and activates it like Enter
+// on a double-click
+// Returns false if the press isn't over a row
+func (t *Table) click(e input.MouseEvent) bool {
+	row := t.rowAt(e.X, e.Y)
+	if row < 0 {
+		return false
+	}
+	double := t.clicks.press(e, row)
+	if row != t.selectedRow {
+		t.SelectRow(row)
+		t.notifyChange()
+	}
+	if double {
+		t.activate()
+	}
+	return true
+}
+
+// rowAt returns the index of the row drawn at (x, y), relative to the
+// top-left corner of the last rendered bounds, or -1 if there is none
+// The header, its separator and row borders belong to no row
+func (t *Table) rowAt(x, y int) int {
+	inner := t.bounds.Width
+	if t.showBorder {
+		x--
+		y--
+		inner -= 2
+	}
+	if t.showScrollBar {
+		inner -= 2
 	}
+	y -= t.headerLines()
+	if x < 0 || x >= inner || y < 0 || y%t.rowStride() != 0 {
+		return -1
+	}
+	line := y / t.rowStride()
+	if line >= t.visibleRowCount() {
+		return -1
+	}
+	row := t.offset + line
+	if row >= t.rowCount() {
+		return -1
+	}
+	return row
+}
+
+// scrollBy scrolls the view by lines rows, moving the selection along with
+// it if the wheel is set to
+func (t *Table) scrollBy(lines int) {
+	if t.wheel.moveCursor {
+		t.selectedRow = max(0, min(t.selectedRow+lines, t.rowCount()-1))
+		t.ensureVisible()
+		t.notifyChange()
+		return
+	}
+	t.offset = clampOffset(t.offset+lines, t.rowCount(), t.visibleRowCount())
+	t.notifyScroll()
+}
+
+func (t *Table) ensureVisible() {
+	visibleRows := t.visibleRowCount()
 	if t.selectedRow < t.offset {
 		t.offset = t.selectedRow
 	}
 	if t.selectedRow >= t.offset+visibleRows {
 		t.offset = t.selectedRow - visibleRows + 1
 	}
+	t.notifyScroll()
+}
+
+// notifyScroll reports the offset to the OnScroll callback if it changed
+func (t *Table) notifyScroll() {
+	t.scrolled.notify(t.offset, t.visibleRowCount(), t.rowCount())
 }
 
 func (t *Table) notifyChange() {
@@ -493,19 +1276,24 @@ func (t *Table) notifyChange() {
 // Size returns the preferred size
 func (t *Table) Size() layout.Size {
 	width := 0
-	for _, col := range t.columns {
+	for i, col := range t.columns {
 		if col.Width > 0 {
 			width += col.Width
+		} else if t.autoFits(col) {
+			width += t.contentWidth(i)
 		} else {
 			width += 10 // Default width
 		}
 	}
-	width += len(t.columns) - 1 // Separators
+	if t.columnBorders {
+		width += len(t.columns) - 1 // Separators
+	}
 
-	height := len(t.rows)
-	if t.showHeader {
-		height += 2
+	height := t.rowCount()
+	if t.rowBorders && height > 1 {
+		height += height - 1
 	}
+	height += t.headerLines()
 	if height > t.height {
 		height = t.height
 	}
@@ -526,3 +1314,11 @@ func (t *Table) MinSize() layout.Size {
 	return layout.NewSize(width, 3)
 }
 
+// AccessibleDescription describes the table and its selected row
+func (t *Table) AccessibleDescription() string {
+	desc := fmt.Sprintf("Table rows=%d", t.rowCount())
+	if row := t.SelectedRow(); row >= 0 && row < t.rowCount() {
+		desc += " current=" + quote(strings.Join(t.rows.Row(row), " | "))
+	}
+	return desc + focusState(t)
+}
@@ -0,0 +1,403 @@
+package widget
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// newTestTable creates a borderless table without a header showing rows
+func newTestTable(columns []TableColumn, rows [][]string) *Table {
+	return NewTable().SetColumns(columns).SetRows(rows).
+		SetShowHeader(false).SetColumnBorders(false)
+}
+
+func TestTableCellTruncation(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "Name", Width: 4}}, [][]string{{"Charlie"}, {"日本語"}, {"Bob"}}).
+		SetEllipsis(true)
+	got := renderWidget(table, 4, 3).ToString()
+	want := "Cha…\n日…\nBob"
+	if got != want {
+		t.Errorf("rendered\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestTableBorders(t *testing.T) {
+	table := NewTable().
+		SetColumns([]TableColumn{{Title: "A", Width: 3}, {Title: "B", Width: 3}}).
+		SetRows([][]string{{"a1", "b1"}, {"a2", "b2"}})
+
+	tests := []struct {
+		columns, rows bool
+		want          string
+	}{
+		{true, true, "A  │B\n───┼───\na1 │b1\n───┼───\na2 │b2\n"},
+		{true, false, "A  │B\n───┼───\na1 │b1\na2 │b2\n\n"},
+		{false, true, "A  B\n──────\na1 b1\n──────\na2 b2\n"},
+		{false, false, "A  B\na1 b1\na2 b2\n\n\n"},
+	}
+	for _, tt := range tests {
+		table.SetColumnBorders(tt.columns).SetRowBorders(tt.rows)
+		if got := renderWidget(table, 7, 6).ToString(); got != tt.want {
+			t.Errorf("column borders %v, row borders %v: rendered\n%s\nwant\n%s", tt.columns, tt.rows, got, tt.want)
+		}
+	}
+}
+
+func TestTableFrozenColumns(t *testing.T) {
+	table := newTestTable(
+		[]TableColumn{{Title: "K", Width: 2}, {Title: "A", Width: 2}, {Title: "B", Width: 2}, {Title: "C", Width: 2}},
+		[][]string{{"k1", "a1", "b1", "c1"}},
+	).SetShowHeader(true).SetColumnBorders(true).SetFrozenColumns(1)
+	table.SetFocused(true)
+
+	if got := renderWidget(table, 5, 3).ToString(); got != "K │A\n──┼──\nk1│a1" {
+		t.Fatalf("rendered\n%s\nbefore scrolling", got)
+	}
+	for _, want := range []string{"K │B\n──┼──\nk1│b1", "K │C\n──┼──\nk1│c1"} {
+		table.HandleEvent(input.KeyEvent{Key: input.KeyRight})
+		if got := renderWidget(table, 5, 3).ToString(); got != want {
+			t.Errorf("rendered\n%s\nwant\n%s", got, want)
+		}
+	}
+
+	// The last column is showing, so Right doesn't scroll further
+	if table.HandleEvent(input.KeyEvent{Key: input.KeyRight}) || table.ColumnOffset() != 2 {
+		t.Errorf("Right scrolled past the last column, offset %d", table.ColumnOffset())
+	}
+}
+
+func TestTableCellSelection(t *testing.T) {
+	table := newTestTable(
+		[]TableColumn{{Title: "A", Width: 2}, {Title: "B", Width: 2}, {Title: "C", Width: 2}},
+		[][]string{{"a1", "b1", "c1"}, {"a2", "b2", "c2"}},
+	).SetSelectionMode(SelectCell)
+	table.SetFocused(true)
+	gotRow, gotCol := -1, -1
+	table.OnSelectCell(func(row, col int) { gotRow, gotCol = row, col })
+
+	send := func(key input.Key) { table.HandleEvent(input.KeyEvent{Key: key}) }
+	send(input.KeyRight)
+	send(input.KeyRight)
+	send(input.KeyRight) // Stops at the last column
+	send(input.KeyLeft)
+	send(input.KeyDown)
+	if row, col := table.SelectedCell(); row != 1 || col != 1 {
+		t.Errorf("SelectedCell() = (%d, %d), want (1, 1)", row, col)
+	}
+	send(input.KeyEnter)
+	if gotRow != 1 || gotCol != 1 {
+		t.Errorf("Enter reported (%d, %d), want (1, 1)", gotRow, gotCol)
+	}
+
+	// Only the selected cell is drawn in the selected style
+	buf := renderWidget(table, 6, 2)
+	if !buf.Get(2, 1, 0).Style.Equals(table.selectedStyle) || buf.Get(0, 1, 0).Style.Equals(table.selectedStyle) {
+		t.Error("selected style not limited to the selected cell")
+	}
+}
+
+// countingRows is a RowProvider recording which rows are fetched
+type countingRows struct {
+	count   int
+	fetched []int
+}
+
+func (c *countingRows) RowCount() int { return c.count }
+
+func (c *countingRows) Row(i int) []string {
+	c.fetched = append(c.fetched, i)
+	return []string{strconv.Itoa(i)}
+}
+
+func TestTableRowProviderFetchesVisibleRows(t *testing.T) {
+	rows := &countingRows{count: 1000}
+	table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, nil).SetRowProvider(rows)
+	table.SetFocused(true)
+	table.SelectRow(500)
+	rows.fetched = nil
+
+	renderWidget(table, 10, 5)
+	if len(rows.fetched) != 5 {
+		t.Fatalf("fetched %d rows, want the 5 visible", len(rows.fetched))
+	}
+	first, _, _ := table.GetScrollInfo()
+	for _, i := range rows.fetched {
+		if i < first-1 || i >= first-1+5 {
+			t.Errorf("fetched row %d outside the visible window from %d", i, first-1)
+		}
+	}
+}
+
+// numberedRows returns n single-cell rows "r0", "r1", ...
+func numberedRows(n int) [][]string {
+	rows := make([][]string, n)
+	for i := range rows {
+		rows[i] = []string{"r" + strconv.Itoa(i)}
+	}
+	return rows
+}
+
+func TestTableKeepsSelectionVisible(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(20))
+	table.SetFocused(true)
+
+	// Selected before the first render at the default height, then drawn
+	// in a smaller viewport
+	table.SelectRow(8)
+	if got := renderWidget(table, 5, 4).ToString(); got != "r5\nr6\nr7\nr8" {
+		t.Errorf("rendered %q, want the selected row r8 at the bottom", got)
+	}
+
+	for i := 0; i < 5; i++ {
+		table.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	}
+	if got := renderWidget(table, 5, 4).ToString(); got != "r10\nr11\nr12\nr13" {
+		t.Errorf("rendered %q, want the view scrolled to r13", got)
+	}
+	table.HandleEvent(input.KeyEvent{Key: input.KeyHome})
+	if got := renderWidget(table, 5, 4).ToString(); got != "r0\nr1\nr2\nr3" {
+		t.Errorf("rendered %q, want the view back at the top", got)
+	}
+}
+
+func TestTableAsciiBorders(t *testing.T) {
+	table := NewTable().
+		SetColumns([]TableColumn{{Title: "A", Width: 2}, {Title: "B", Width: 2}}).
+		SetRows([][]string{{"1", "2"}, {"3", "4"}}).
+		SetShowBorder(true).SetRowBorders(true)
+
+	unicode := "┌───────┐\n│A │B   │\n│──┼──  │\n│1 │2   │\n│──┼──  │\n│3 │4   │\n└───────┘"
+	ascii := "+-------+\n|A |B   |\n|--+--  |\n|1 |2   |\n|--+--  |\n|3 |4   |\n+-------+"
+	if got := renderWidget(table, 9, 7).ToString(); got != unicode {
+		t.Errorf("rendered\n%s\nwant\n%s", got, unicode)
+	}
+	if got := renderWidget(table.SetAscii(true), 9, 7).ToString(); got != ascii {
+		t.Errorf("with SetAscii rendered\n%s\nwant\n%s", got, ascii)
+	}
+
+	// The global capability flag applies to tables that don't set it
+	screen.SetAsciiOnly(true)
+	t.Cleanup(func() { screen.SetAsciiOnly(false) })
+	if got := renderWidget(table.SetAscii(false), 9, 7).ToString(); got != ascii {
+		t.Errorf("with screen.AsciiOnly rendered\n%s\nwant\n%s", got, ascii)
+	}
+}
+
+func TestTableNumericColumnAlignment(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "N", Width: 6, Numeric: true}},
+		[][]string{{"1"}, {"12.5"}, {"300"}})
+	want := "     1\n  12.5\n   300"
+	if got := renderWidget(table, 6, 3).ToString(); got != want {
+		t.Errorf("numeric column rendered\n%q\nwant\n%q", got, want)
+	}
+
+	// Alignment counts display width, not bytes
+	table = newTestTable([]TableColumn{{Title: "S", Width: 6, Align: layout.AlignEnd}},
+		[][]string{{"é"}, {"日本"}, {"ab"}})
+	want = "     é\n  日本\n    ab"
+	if got := renderWidget(table, 6, 3).ToString(); got != want {
+		t.Errorf("multibyte column rendered\n%q\nwant\n%q", got, want)
+	}
+
+	// An explicit alignment wins over the numeric default
+	table = newTestTable([]TableColumn{{Title: "N", Width: 6, Numeric: true, Align: layout.AlignCenter}},
+		[][]string{{"42"}})
+	if got := renderWidget(table, 6, 1).ToString(); got != "  42" {
+		t.Errorf("centered numeric column rendered %q, want %q", got, "  42")
+	}
+}
+
+func TestTableNumericSort(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "N", Width: 4, Numeric: true}},
+		[][]string{{"10"}, {"x"}, {"9"}, {"2.5"}})
+	table.SortBy(0, false)
+	if got, want := renderWidget(table, 4, 4).ToString(), " 2.5\n   9\n  10\n   x"; got != want {
+		t.Errorf("sorted rendered\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestTableHighlightsMatches(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "A", Width: 4}, {Title: "B", Width: 4}},
+		[][]string{{"café", "xy"}, {"tea", "zz"}}).
+		SetMatches(func(row, col int) []int {
+			if row == 0 && col == 0 {
+				return []int{0, 3}
+			}
+			return nil
+		})
+	buf := renderWidget(table, 8, 2)
+
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 2; y++ {
+			want := y == 0 && (x == 0 || x == 3)
+			if got := buf.Get(x, y, 0).Style == table.matchStyle; got != want {
+				t.Errorf("cell (%d, %d) highlighted = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestTableWrapSelection(t *testing.T) {
+	up, down := input.KeyEvent{Key: input.KeyUp}, input.KeyEvent{Key: input.KeyDown}
+	tests := []struct {
+		name   string
+		wrap   bool
+		moves  []input.KeyEvent
+		row    int
+		offset int
+	}{
+		{"up at top", false, []input.KeyEvent{up}, 0, 0},
+		{"wrap up at top", true, []input.KeyEvent{up}, 9, 7},
+		{"wrap up and down", true, []input.KeyEvent{up, down}, 0, 0},
+	}
+	for _, tt := range tests {
+		table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(10)).SetWrapSelection(tt.wrap)
+		table.SetFocused(true)
+		renderWidget(table, 5, 3)
+		for _, key := range tt.moves {
+			table.HandleEvent(key)
+		}
+		if table.SelectedRow() != tt.row || table.offset != tt.offset {
+			t.Errorf("%s: row, offset = %d, %d, want %d, %d", tt.name, table.SelectedRow(), table.offset, tt.row, tt.offset)
+		}
+	}
+
+	// Without wrapping Down at the last row stays there
+	table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(3))
+	table.SetFocused(true)
+	for range 4 {
+		table.HandleEvent(down)
+	}
+	if table.SelectedRow() != 2 {
+		t.Errorf("row after Down past the end = %d, want 2", table.SelectedRow())
+	}
+}
+
+func TestTableAutoFit(t *testing.T) {
+	rows := [][]string{{"Ann", "x"}, {"Bartholomew", "y"}}
+	columns := []TableColumn{{Title: "Name"}, {Title: "Note", Flex: 1}}
+
+	table := newTestTable(columns, rows)
+	if got := table.calculateColumnWidths([]int{0, 1}, 30); !slices.Equal(got, []int{15, 15}) {
+		t.Errorf("widths without auto-fit = %v, want [15 15]", got)
+	}
+	table.SetAutoFit(true)
+	if got := table.calculateColumnWidths([]int{0, 1}, 30); !slices.Equal(got, []int{11, 19}) {
+		t.Errorf("widths with auto-fit = %v, want [11 19]", got)
+	}
+
+	// The header counts when it is shown and longer than the values
+	table = newTestTable([]TableColumn{{Title: "Description"}}, [][]string{{"a"}}).
+		SetShowHeader(true).SetAutoFit(true)
+	if got := table.calculateColumnWidths([]int{0}, 30); !slices.Equal(got, []int{11}) {
+		t.Errorf("widths with a long title = %v, want [11]", got)
+	}
+}
+
+func TestTableAutoFitClampsToViewport(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "Name"}, {Title: "Note", Flex: 1}},
+		[][]string{{"Bartholomew", "x"}}).SetAutoFit(true)
+	if got := table.calculateColumnWidths([]int{0, 1}, 6); !slices.Equal(got, []int{6, 0}) {
+		t.Errorf("widths = %v, want [6 0]", got)
+	}
+	if got := renderWidget(table, 6, 1).ToString(); got != "Bartho" {
+		t.Errorf("render = %q, want %q", got, "Bartho")
+	}
+}
+
+func TestTableZebra(t *testing.T) {
+	even := terminal.DefaultStyle().WithBG(terminal.ColorBlue)
+	odd := terminal.DefaultStyle().WithBG(terminal.ColorRed)
+	table := newTestTable([]TableColumn{{Title: "N", Flex: 1}}, numberedRows(6)).SetZebra(even, odd)
+	table.SetFocused(true)
+	renderWidget(table, 4, 3)
+	for range 3 {
+		table.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	}
+
+	buf := renderWidget(table, 4, 3)
+	for y, want := range []terminal.Style{odd, even, table.selectedStyle} {
+		if got := buf.Get(0, y, 0).Style; got != want {
+			t.Errorf("row %d style = %+v, want %+v", y, got, want)
+		}
+	}
+}
+
+func TestTableColumnRender(t *testing.T) {
+	red := terminal.DefaultStyle().WithFG(terminal.ColorRed)
+	status := func(value string, width int, style terminal.Style) []StyledRun {
+		return []StyledRun{{Text: "●", Style: red}, {Text: " " + value, Style: style}}
+	}
+	table := newTestTable([]TableColumn{
+		{Title: "Status", Width: 4, Render: status},
+		{Title: "N", Width: 3, Align: layout.AlignEnd, Render: func(value string, width int, style terminal.Style) []StyledRun {
+			return []StyledRun{{Text: value, Style: style}}
+		}},
+	}, [][]string{{"ok", "7"}, {"down", "1234"}})
+	buf := renderWidget(table, 7, 2)
+
+	want := "● ok  7\n● do123"
+	if got := buf.ToString(); got != want {
+		t.Errorf("rendered\n%q\nwant\n%q", got, want)
+	}
+	if !buf.Get(0, 1, 0).Style.Equals(red) {
+		t.Error("first run not drawn in its own style")
+	}
+}
+
+func TestTableMultiSelect(t *testing.T) {
+	table := newTestTable([]TableColumn{{Title: "Name", Width: 5}}, numberedRows(4)).SetMultiSelect(true)
+	table.SetFocused(true)
+	var activated []int
+	table.OnActivateSelection(func(rows []int) { activated = rows })
+
+	space := input.KeyEvent{Key: input.KeyRune, Rune: ' '}
+	down := input.KeyEvent{Key: input.KeyDown}
+	for _, e := range []input.KeyEvent{space, down, down, space, down, space, space} {
+		table.HandleEvent(e)
+	}
+	if got, want := table.SelectedRows(), []int{0, 2}; !slices.Equal(got, want) {
+		t.Errorf("SelectedRows() = %v, want %v", got, want)
+	}
+
+	buf := renderWidget(table, 5, 4)
+	if !buf.Get(0, 2, 0).Style.Equals(table.markedStyle) || buf.Get(0, 1, 0).Style.Equals(table.markedStyle) {
+		t.Error("marked rows not drawn in the marked style")
+	}
+
+	table.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if want := []int{0, 2}; !slices.Equal(activated, want) {
+		t.Errorf("Enter activated %v, want %v", activated, want)
+	}
+
+	table.ClearSelection()
+	table.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if want := []int{3}; !slices.Equal(activated, want) {
+		t.Errorf("Enter with nothing marked activated %v, want %v", activated, want)
+	}
+}
+
+func TestTableMarksSurviveSort(t *testing.T) {
+	rows := [][]string{{"b"}, {"d"}, {"a"}, {"c"}}
+	for _, keyed := range []bool{false, true} {
+		table := newTestTable([]TableColumn{{Title: "Name", Width: 5}}, slices.Clone(rows)).SetMultiSelect(true)
+		if keyed {
+			table.SetRowKey(func(row []string) string { return row[0] })
+		}
+		table.ToggleRow(0).ToggleRow(1) // "b" and "d"
+		table.SortBy(0, false)
+
+		if got, want := table.SelectedRows(), []int{1, 3}; !slices.Equal(got, want) {
+			t.Errorf("keyed=%v: SelectedRows() after sort = %v, want %v", keyed, got, want)
+		}
+		if !table.IsRowSelected(1) || table.IsRowSelected(0) {
+			t.Errorf("keyed=%v: IsRowSelected doesn't follow the sort", keyed)
+		}
+	}
+}
@@ -9,13 +9,46 @@ import (
 	"github.com/agiles231/gotui/terminal"
 )
 
+// WordBreak controls how words longer than the wrap width are handled
+type WordBreak int
+
+const (
+	// WordBreakNormal keeps long words intact on their own line (clipped)
+	WordBreakNormal WordBreak = iota
+	// WordBreakAll hard-breaks long words across lines at the wrap width
+	WordBreakAll
+)
+
+// StyledRun is a segment of text drawn in a single style
+type StyledRun struct {
+	Text  string
+	Style terminal.Style
+}
+
+// styledRune is a single rune with the style it is drawn in
+type styledRune struct {
+	r     rune
+	style terminal.Style
+}
+
+// styledWord is a word of styled runes with the space that preceded it
+type styledWord struct {
+	sep   styledRune
+	runes []styledRune
+}
+
 // Text is a static text label widget
+// Content is either a plain string drawn in a single style, or a sequence
+// of styled runs for inline styling
 type Text struct {
 	BaseWidget
 	text      string
+	runs      []StyledRun // When set, overrides text and style
 	style     terminal.Style
 	alignment layout.Alignment
+	vAlign    layout.Alignment
 	wrap      bool
+	wordBreak WordBreak
 }
 
 // NewText creates a new text widget
@@ -25,21 +58,46 @@ func NewText(text string) *Text {
 		text:       text,
 		style:      terminal.DefaultStyle(),
 		alignment:  layout.AlignStart,
+		vAlign:     layout.AlignStart,
 	}
 }
 
-// SetText sets the text content
+// NewRichText creates a new text widget from inline styled runs
+func NewRichText(runs []StyledRun) *Text {
+	return NewText("").SetRuns(runs)
+}
+
+// SetText sets the text content, replacing any styled runs
 func (t *Text) SetText(text string) *Text {
 	t.text = text
+	t.runs = nil
 	return t
 }
 
 // Text returns the text content
 func (t *Text) Text() string {
-	return t.text
+	if t.runs == nil {
+		return t.text
+	}
+	var sb strings.Builder
+	for _, run := range t.runs {
+		sb.WriteString(run.Text)
+	}
+	return sb.String()
+}
+
+// SetRuns sets the content as inline styled runs
+func (t *Text) SetRuns(runs []StyledRun) *Text {
+	t.runs = runs
+	return t
+}
+
+// Runs returns the styled runs, or nil for plain text
+func (t *Text) Runs() []StyledRun {
+	return t.runs
 }
 
-// SetStyle sets the text style
+// SetStyle sets the text style (plain text only; runs carry their own styles)
 func (t *Text) SetStyle(style terminal.Style) *Text {
 	t.style = style
 	return t
@@ -51,19 +109,39 @@ func (t *Text) SetAlignment(alignment layout.Alignment) *Text {
 	return t
 }
 
+// SetVerticalAlignment sets how the lines are positioned within taller bounds
+func (t *Text) SetVerticalAlignment(alignment layout.Alignment) *Text {
+	t.vAlign = alignment
+	return t
+}
+
 // SetWrap enables or disables text wrapping
 func (t *Text) SetWrap(wrap bool) *Text {
 	t.wrap = wrap
 	return t
 }
 
+// SetWordBreak sets how words longer than the wrap width are handled
+func (t *Text) SetWordBreak(wordBreak WordBreak) *Text {
+	t.wordBreak = wordBreak
+	return t
+}
+
 // Render draws the text widget
 func (t *Text) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !t.visible {
+	t.bounds = bounds
+	if !t.visible || bounds.IsEmpty() {
 		return
 	}
 
 	lines := t.getLines(bounds.Width)
+
+	// Calculate y offset based on vertical alignment
+	top := bounds.Y
+	if len(lines) < bounds.Height {
+		top += layout.Align(len(lines), bounds.Height, t.vAlign)
+	}
+
 	for i, line := range lines {
 		if i >= bounds.Height {
 			break
@@ -71,41 +149,82 @@ func (t *Text) Render(buf *screen.Buffer, bounds layout.Rect) {
 
 		// Calculate x offset based on alignment
 		x := bounds.X
-		switch t.alignment {
-		case layout.AlignCenter:
-			x += (bounds.Width - len(line)) / 2
-		case layout.AlignEnd:
-			x += bounds.Width - len(line)
+		if width := lineWidth(line); width < bounds.Width {
+			x += layout.Align(width, bounds.Width, t.alignment)
 		}
 
-		buf.DrawStringClipped(x, bounds.Y+i, bounds.Z, line, t.style, bounds.Width)
+		// Wide runes take two cells; stop before one would pass the edge
+		col := 0
+		for _, sr := range line {
+			w := screen.RuneWidth(sr.r)
+			if col+w > bounds.Width {
+				break
+			}
+			buf.DrawString(x+col, top+i, bounds.Z, string(sr.r), sr.style)
+			col += w
+		}
+	}
+}
+
+// content returns the text as styled runs
+func (t *Text) content() []StyledRun {
+	if t.runs != nil {
+		return t.runs
+	}
+	return []StyledRun{{Text: t.text, Style: t.style}}
+}
+
+// splitLines splits the content into lines of styled runes at newlines
+func (t *Text) splitLines() [][]styledRune {
+	lines := [][]styledRune{nil}
+	for _, run := range t.content() {
+		for _, r := range run.Text {
+			if r == '\n' {
+				lines = append(lines, nil)
+				continue
+			}
+			last := len(lines) - 1
+			lines[last] = append(lines[last], styledRune{r: r, style: run.Style})
+		}
 	}
+	return lines
 }
 
 // getLines splits text into lines, optionally wrapping
-func (t *Text) getLines(maxWidth int) []string {
+// Wrapping works across run boundaries and each rune keeps its run's style
+func (t *Text) getLines(maxWidth int) [][]styledRune {
+	lines := t.splitLines()
 	if !t.wrap || maxWidth <= 0 {
-		return strings.Split(t.text, "\n")
+		return lines
 	}
 
-	var result []string
-	for _, line := range strings.Split(t.text, "\n") {
-		if len(line) <= maxWidth {
+	var result [][]styledRune
+	for _, line := range lines {
+		if lineWidth(line) <= maxWidth {
 			result = append(result, line)
 			continue
 		}
 
 		// Word wrap
-		words := strings.Fields(line)
-		current := ""
-		for _, word := range words {
-			if len(current) == 0 {
-				current = word
-			} else if len(current)+1+len(word) <= maxWidth {
-				current += " " + word
+		var current []styledRune
+		for _, word := range splitWords(line) {
+			wordWidth := lineWidth(word.runes)
+			if t.wordBreak == WordBreakAll && wordWidth > maxWidth {
+				// Hard-break the word, carrying its last piece forward
+				if len(current) > 0 {
+					result = append(result, current)
+				}
+				pieces := breakWord(word.runes, maxWidth)
+				result = append(result, pieces[:len(pieces)-1]...)
+				current = pieces[len(pieces)-1]
+			} else if len(current) == 0 {
+				current = append([]styledRune(nil), word.runes...)
+			} else if lineWidth(current)+1+wordWidth <= maxWidth {
+				current = append(current, word.sep)
+				current = append(current, word.runes...)
 			} else {
 				result = append(result, current)
-				current = word
+				current = append([]styledRune(nil), word.runes...)
 			}
 		}
 		if len(current) > 0 {
@@ -116,6 +235,60 @@ func (t *Text) getLines(maxWidth int) []string {
 	return result
 }
 
+// splitWords splits a line into words separated by spaces
+func splitWords(line []styledRune) []styledWord {
+	var words []styledWord
+	var sep styledRune
+	start := -1
+	for i, sr := range line {
+		if sr.r == ' ' {
+			if start >= 0 {
+				words = append(words, styledWord{sep: sep, runes: line[start:i]})
+				start = -1
+			}
+			if start < 0 {
+				sep = sr
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, styledWord{sep: sep, runes: line[start:]})
+	}
+	return words
+}
+
+// breakWord splits a word into pieces no wider than maxWidth
+// A single rune wider than maxWidth is kept as its own piece
+func breakWord(word []styledRune, maxWidth int) [][]styledRune {
+	var pieces [][]styledRune
+	var current []styledRune
+	currentWidth := 0
+	for _, sr := range word {
+		w := screen.RuneWidth(sr.r)
+		if currentWidth+w > maxWidth && currentWidth > 0 {
+			pieces = append(pieces, current)
+			current = nil
+			currentWidth = 0
+		}
+		current = append(current, sr)
+		currentWidth += w
+	}
+	return append(pieces, current)
+}
+
+// lineWidth returns the display width of a line of styled runes
+func lineWidth(line []styledRune) int {
+	width := 0
+	for _, sr := range line {
+		width += screen.RuneWidth(sr.r)
+	}
+	return width
+}
+
 // HandleEvent handles input events (text widgets don't handle input)
 func (t *Text) HandleEvent(event input.Event) bool {
 	return false
@@ -123,11 +296,11 @@ func (t *Text) HandleEvent(event input.Event) bool {
 
 // Size returns the preferred size
 func (t *Text) Size() layout.Size {
-	lines := strings.Split(t.text, "\n")
+	lines := t.splitLines()
 	maxWidth := 0
 	for _, line := range lines {
-		if len(line) > maxWidth {
-			maxWidth = len(line)
+		if w := lineWidth(line); w > maxWidth {
+			maxWidth = w
 		}
 	}
 	return layout.NewSize(maxWidth, len(lines))
@@ -160,3 +333,7 @@ func Colored(text string, fg terminal.Color) *Text {
 	return NewText(text).SetStyle(terminal.DefaultStyle().WithFG(fg))
 }
 
+// AccessibleDescription describes the text by its content
+func (t *Text) AccessibleDescription() string {
+	return "Text " + quote(t.Text())
+}
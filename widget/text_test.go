@@ -0,0 +1,104 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/terminal"
+)
+
+func TestTextWordBreakAll(t *testing.T) {
+	word := strings.Repeat("abcdefghij", 3)
+	text := NewText(word).SetWrap(true).SetWordBreak(WordBreakAll)
+	got := renderWidget(text, 10, 4).ToString()
+	want := "abcdefghij\nabcdefghij\nabcdefghij\n"
+	if got != want {
+		t.Errorf("rendered\n%q\nwant\n%q", got, want)
+	}
+
+	// WordBreakNormal keeps the word on one clipped line
+	text.SetWordBreak(WordBreakNormal)
+	if got := renderWidget(text, 10, 4).ToString(); got != "abcdefghij\n\n\n" {
+		t.Errorf("WordBreakNormal rendered %q, want one clipped line", got)
+	}
+}
+
+func TestTextWrapCountsDisplayWidth(t *testing.T) {
+	// Each CJK rune is two cells wide, so four fit in 8 cells
+	lines := NewText("日本語日本語").SetWrap(true).SetWordBreak(WordBreakAll).getLines(8)
+	if len(lines) != 2 || lineWidth(lines[0]) != 8 || lineWidth(lines[1]) != 4 {
+		t.Errorf("got %d lines, want widths 8 and 4", len(lines))
+	}
+}
+
+func TestTextRendersWideRunes(t *testing.T) {
+	tests := []struct {
+		text  string
+		align layout.Alignment
+		want  string
+	}{
+		{"日本語ab", layout.AlignStart, "日本語ab"},
+		{"日本語ab", layout.AlignCenter, " 日本語ab"},
+		{"日本語ab", layout.AlignEnd, "   日本語ab"},
+		{"a日本", layout.AlignEnd, "      a日本"},
+		// A wide rune that would straddle the edge is left out
+		{"日本語日本語", layout.AlignStart, "日本語日本"},
+		{"日本語日本語", layout.AlignCenter, "日本語日本"},
+		{"日本語日本語", layout.AlignEnd, "日本語日本"},
+	}
+	for _, tt := range tests {
+		text := NewText(tt.text).SetAlignment(tt.align)
+		if got := renderWidget(text, 11, 1).ToString(); got != tt.want {
+			t.Errorf("%q aligned %v rendered %q, want %q", tt.text, tt.align, got, tt.want)
+		}
+	}
+}
+
+func TestTextVerticalAlignment(t *testing.T) {
+	tests := []struct {
+		align layout.Alignment
+		top   int
+	}{
+		{layout.AlignStart, 0},
+		{layout.AlignCenter, 3},
+		{layout.AlignEnd, 6},
+	}
+	for _, tt := range tests {
+		text := NewText("one\ntwo\nthree").SetVerticalAlignment(tt.align)
+		buf := renderWidget(text, 10, 9)
+		for y := 0; y < 9; y++ {
+			want := ' '
+			if y >= tt.top && y < tt.top+3 {
+				want = []rune("ott")[y-tt.top]
+			}
+			if got := buf.Get(0, y, 0).Rune; got != want {
+				t.Errorf("alignment %v: row %d starts with %q, want %q", tt.align, y, got, want)
+			}
+		}
+	}
+}
+
+func TestRichTextWrapKeepsRunStyles(t *testing.T) {
+	plain := terminal.DefaultStyle()
+	green := plain.WithFG(terminal.ColorGreen)
+	text := NewRichText([]StyledRun{
+		{Text: "Status: ", Style: plain},
+		{Text: "all good", Style: green},
+	}).SetWrap(true)
+	buf := renderWidget(text, 12, 2)
+
+	if got := buf.ToString(); got != "Status: all\ngood" {
+		t.Fatalf("rendered %q, want the green run wrapped after \"all\"", got)
+	}
+	for _, c := range []struct {
+		x, y  int
+		style terminal.Style
+	}{
+		{0, 0, plain}, {7, 0, plain}, {8, 0, green}, {10, 0, green}, {0, 1, green}, {3, 1, green},
+	} {
+		if got := buf.Get(c.x, c.y, 0).Style; !got.Equals(c.style) {
+			t.Errorf("cell (%d, %d) style = %v, want %v", c.x, c.y, got, c.style)
+		}
+	}
+}
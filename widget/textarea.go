@@ -0,0 +1,397 @@
+package widget
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// TextArea is a multi-line text editing widget
+// Keys edit as in TextInput, with the keymap's Up and Down moving between
+// lines, Enter starting a new line, and Home/End and the kill keys acting
+// on the current line. Tab is left for the parent to move focus with.
+type TextArea struct {
+	BaseWidget
+	value        []rune
+	cursor       int // Rune index into value
+	row          int // First line shown
+	col          int // First column shown
+	placeholder  string
+	style        terminal.Style
+	focusedStyle terminal.Style
+	cursorStyle  terminal.Style
+	width        int
+	height       int
+	lastWidth    int // Width from the last render
+	lastHeight   int // Height from the last render
+	onChange     func(string)
+	history      editHistory
+	keymap       EditKeymap
+}
+
+// NewTextArea creates a new text area widget
+func NewTextArea() *TextArea {
+	ta := &TextArea{
+		BaseWidget:   NewBaseWidget(),
+		value:        []rune{},
+		style:        terminal.DefaultStyle(),
+		focusedStyle: terminal.DefaultStyle(),
+		cursorStyle:  terminal.DefaultStyle().WithReverse(),
+		width:        40,
+		height:       5,
+		history:      newEditHistory(),
+		keymap:       EmacsKeymap{},
+	}
+	ta.SetInteractive(true)
+	return ta
+}
+
+// SetKeymap sets the editing keys, EmacsKeymap by default
+// Pass a keymap such as NewViKeymap() for modal editing.
+func (ta *TextArea) SetKeymap(keymap EditKeymap) *TextArea {
+	if keymap == nil {
+		keymap = EmacsKeymap{}
+	}
+	ta.keymap = keymap
+	return ta
+}
+
+// SetValue sets the text
+func (ta *TextArea) SetValue(value string) *TextArea {
+	ta.value = []rune(value)
+	ta.cursor = min(ta.cursor, len(ta.value))
+	ta.scrollToCursor()
+	return ta
+}
+
+// Value returns the text
+func (ta *TextArea) Value() string {
+	return string(ta.value)
+}
+
+// FieldValue returns the text, for forms
+func (ta *TextArea) FieldValue() string {
+	return ta.Value()
+}
+
+// SetFieldValue sets the text, for forms
+func (ta *TextArea) SetFieldValue(value string) {
+	ta.SetValue(value)
+}
+
+// Cursor returns the line and column of the cursor, counted in runes from 0
+func (ta *TextArea) Cursor() (int, int) {
+	start := ta.lineStart(ta.cursor)
+	return strings.Count(string(ta.value[:start]), "\n"), ta.cursor - start
+}
+
+// SetCursor moves the cursor to line and column, clamped to the text
+func (ta *TextArea) SetCursor(line, col int) *TextArea {
+	ta.cursor = ta.indexAt(line, col)
+	ta.scrollToCursor()
+	return ta
+}
+
+// SetPlaceholder sets text shown while the area is empty and unfocused
+func (ta *TextArea) SetPlaceholder(placeholder string) *TextArea {
+	ta.placeholder = placeholder
+	return ta
+}
+
+// SetSize sets the preferred width and height
+func (ta *TextArea) SetSize(width, height int) *TextArea {
+	ta.width = width
+	ta.height = height
+	return ta
+}
+
+// SetStyle sets the normal style
+func (ta *TextArea) SetStyle(style terminal.Style) *TextArea {
+	ta.style = style
+	return ta
+}
+
+// SetFocusedStyle sets the focused style
+func (ta *TextArea) SetFocusedStyle(style terminal.Style) *TextArea {
+	ta.focusedStyle = style
+	return ta
+}
+
+// OnChange sets the change callback
+func (ta *TextArea) OnChange(fn func(string)) *TextArea {
+	ta.onChange = fn
+	return ta
+}
+
+// lines returns the text split into lines
+func (ta *TextArea) lines() []string {
+	return strings.Split(string(ta.value), "\n")
+}
+
+// lineStart returns the index of the start of the line containing pos
+func (ta *TextArea) lineStart(pos int) int {
+	for pos > 0 && ta.value[pos-1] != '\n' {
+		pos--
+	}
+	return pos
+}
+
+// lineEnd returns the index of the end of the line containing pos
+func (ta *TextArea) lineEnd(pos int) int {
+	for pos < len(ta.value) && ta.value[pos] != '\n' {
+		pos++
+	}
+	return pos
+}
+
+// indexAt returns the index of line and column, clamped to the text
+func (ta *TextArea) indexAt(line, col int) int {
+	pos := 0
+	for ; line > 0; line-- {
+		end := ta.lineEnd(pos)
+		if end == len(ta.value) {
+			break
+		}
+		pos = end + 1
+	}
+	return min(pos+max(0, col), ta.lineEnd(pos))
+}
+
+// Render draws the text area
+func (ta *TextArea) Render(buf *screen.Buffer, bounds layout.Rect) {
+	ta.bounds = bounds
+	if !ta.visible || bounds.IsEmpty() {
+		return
+	}
+	ta.lastWidth, ta.lastHeight = bounds.Width, bounds.Height
+	ta.scrollToCursor()
+
+	style := ta.style
+	if ta.focused {
+		style = ta.focusedStyle
+	}
+	buf.FillRect(bounds.X, bounds.Y, bounds.Z, bounds.Width, bounds.Height, screen.NewCell(' ', style))
+
+	if len(ta.value) == 0 && !ta.focused {
+		buf.DrawStringClipped(bounds.X, bounds.Y, bounds.Z, ta.placeholder, style.WithDim(), bounds.Width)
+		return
+	}
+
+	lines := ta.lines()
+	for y := 0; y < bounds.Height && ta.row+y < len(lines); y++ {
+		line := []rune(lines[ta.row+y])
+		if ta.col < len(line) {
+			buf.DrawStringClipped(bounds.X, bounds.Y+y, bounds.Z, string(line[ta.col:]), style, bounds.Width)
+		}
+	}
+
+	if ta.focused {
+		line, col := ta.Cursor()
+		x, y := bounds.X+col-ta.col, bounds.Y+line-ta.row
+		if x >= bounds.X && x < bounds.Right() && y >= bounds.Y && y < bounds.Bottom() {
+			cursorChar := ' '
+			if ta.cursor < len(ta.value) && ta.value[ta.cursor] != '\n' {
+				cursorChar = ta.value[ta.cursor]
+			}
+			buf.Set(x, y, bounds.Z, screen.NewCell(cursorChar, ta.cursorStyle))
+		}
+	}
+}
+
+// HandleEvent handles input events
+func (ta *TextArea) HandleEvent(event input.Event) bool {
+	if !ta.focused {
+		return false
+	}
+
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok {
+		return false
+	}
+	action := ta.keymap.Action(keyEvent)
+	if action != EditInsert {
+		ta.history.endGroup()
+	}
+
+	switch action {
+	case EditNone, EditTab:
+		return false
+	case EditInsert:
+		ta.insert(keyEvent.Rune)
+	case EditSubmit:
+		ta.insert('\n')
+	case EditLeft:
+		ta.cursor = max(0, ta.cursor-1)
+	case EditRight:
+		ta.cursor = min(len(ta.value), ta.cursor+1)
+	case EditUp:
+		ta.moveLines(-1)
+	case EditDown:
+		ta.moveLines(1)
+	case EditWordLeft:
+		ta.cursor = ta.wordStart(ta.cursor)
+	case EditWordRight:
+		ta.wordRight()
+	case EditHome:
+		ta.cursor = ta.lineStart(ta.cursor)
+	case EditEnd:
+		ta.cursor = ta.lineEnd(ta.cursor)
+	case EditBackspace:
+		if ta.cursor > 0 {
+			ta.replace(ta.cursor-1, ta.cursor, ta.cursor-1)
+		}
+	case EditDelete:
+		if ta.cursor < len(ta.value) {
+			ta.replace(ta.cursor, ta.cursor+1, ta.cursor)
+		}
+	case EditKillToEnd:
+		end := ta.lineEnd(ta.cursor)
+		if end == ta.cursor && end < len(ta.value) {
+			end++ // At the end of a line, join the next one
+		}
+		ta.replace(ta.cursor, end, ta.cursor)
+	case EditKillToStart:
+		start := ta.lineStart(ta.cursor)
+		ta.replace(start, ta.cursor, start)
+	case EditKillWord:
+		start := ta.wordStart(ta.cursor)
+		ta.replace(start, ta.cursor, start)
+	case EditUndo:
+		ta.Undo()
+	case EditRedo:
+		ta.Redo()
+	}
+	ta.scrollToCursor()
+	return true
+}
+
+// CanUndo returns true if there is an edit to undo
+func (ta *TextArea) CanUndo() bool {
+	return len(ta.history.undo) > 0
+}
+
+// CanRedo returns true if there is an undone edit to redo
+func (ta *TextArea) CanRedo() bool {
+	return len(ta.history.redo) > 0
+}
+
+// Undo reverts the last edit, a run of typing counting as one
+func (ta *TextArea) Undo() *TextArea {
+	if s, ok := ta.history.undoFrom(ta.snapshot()); ok {
+		ta.restore(s)
+	}
+	return ta
+}
+
+// Redo reapplies the last undone edit
+func (ta *TextArea) Redo() *TextArea {
+	if s, ok := ta.history.redoFrom(ta.snapshot()); ok {
+		ta.restore(s)
+	}
+	return ta
+}
+
+// snapshot returns the current text and cursor
+func (ta *TextArea) snapshot() textSnapshot {
+	return textSnapshot{value: ta.value, cursor: ta.cursor}
+}
+
+// restore sets the text and cursor from a snapshot
+func (ta *TextArea) restore(s textSnapshot) {
+	ta.value = s.value
+	ta.cursor = s.cursor
+	ta.scrollToCursor()
+	ta.notifyChange()
+}
+
+// insert types r at the cursor
+func (ta *TextArea) insert(r rune) {
+	ta.history.record(ta.snapshot(), r != '\n')
+	ta.value = slices.Insert(ta.value, ta.cursor, r)
+	ta.cursor++
+	ta.notifyChange()
+}
+
+// replace deletes the runes from start to end and puts the cursor at
+// cursor, recording the edit for undo
+func (ta *TextArea) replace(start, end, cursor int) {
+	if start == end {
+		return
+	}
+	ta.history.record(ta.snapshot(), false)
+	ta.value = slices.Delete(ta.value, start, end)
+	ta.cursor = cursor
+	ta.notifyChange()
+}
+
+// moveLines moves the cursor by lines, keeping its column where the line
+// is long enough
+func (ta *TextArea) moveLines(delta int) {
+	line, col := ta.Cursor()
+	ta.cursor = ta.indexAt(max(0, line+delta), col)
+	ta.scrollToCursor()
+}
+
+// wordStart returns the start of the word before pos
+func (ta *TextArea) wordStart(pos int) int {
+	for pos > 0 && isSpace(ta.value[pos-1]) {
+		pos--
+	}
+	for pos > 0 && !isSpace(ta.value[pos-1]) {
+		pos--
+	}
+	return pos
+}
+
+// wordRight moves the cursor to the start of the next word
+func (ta *TextArea) wordRight() {
+	for ta.cursor < len(ta.value) && !isSpace(ta.value[ta.cursor]) {
+		ta.cursor++
+	}
+	for ta.cursor < len(ta.value) && isSpace(ta.value[ta.cursor]) {
+		ta.cursor++
+	}
+}
+
+// isSpace returns whether r separates words
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n'
+}
+
+// scrollToCursor scrolls so the cursor is in view at the last rendered
+// size, or the preferred size before the first render
+func (ta *TextArea) scrollToCursor() {
+	width, height := ta.lastWidth, ta.lastHeight
+	if width <= 0 || height <= 0 {
+		width, height = max(1, ta.width), max(1, ta.height)
+	}
+	line, col := ta.Cursor()
+	ta.row = max(min(ta.row, line), line-height+1)
+	ta.col = max(min(ta.col, col), col-width+1)
+}
+
+// notifyChange calls the change callback
+func (ta *TextArea) notifyChange() {
+	if ta.onChange != nil {
+		ta.onChange(string(ta.value))
+	}
+}
+
+// Size returns the preferred size
+func (ta *TextArea) Size() layout.Size {
+	return layout.NewSize(ta.width, ta.height)
+}
+
+// MinSize returns the minimum size
+func (ta *TextArea) MinSize() layout.Size {
+	return layout.NewSize(5, 1)
+}
+
+// AccessibleDescription describes the text area and its value
+func (ta *TextArea) AccessibleDescription() string {
+	return "TextArea value=" + quote(string(ta.value)) + focusState(ta)
+}
@@ -2,6 +2,7 @@ package widget
 
 import (
 	"strings"
+	"time"
 
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
@@ -21,8 +22,13 @@ type TextInput struct {
 	cursorStyle  terminal.Style
 	width        int
 	mask         rune // For password fields
+	tabInserts   bool // Tab is typed into the text instead of moving focus
+	tabWidth     int  // Spaces inserted for Tab, or 0 for a tab character
 	onChange     func(string)
 	onSubmit     func(string)
+	debounce     debouncer
+	history      editHistory
+	keymap       EditKeymap
 }
 
 // NewTextInput creates a new text input widget
@@ -34,11 +40,25 @@ func NewTextInput() *TextInput {
 		focusedStyle: terminal.DefaultStyle().WithReverse(),
 		cursorStyle:  terminal.DefaultStyle().WithReverse(),
 		width:        20,
+		tabWidth:     4,
+		debounce:     newDebouncer(),
+		history:      newEditHistory(),
+		keymap:       EmacsKeymap{},
 	}
 	ti.SetInteractive(true)
 	return ti
 }
 
+// SetKeymap sets the editing keys, EmacsKeymap by default
+// Pass a keymap such as NewViKeymap() for modal editing.
+func (ti *TextInput) SetKeymap(keymap EditKeymap) *TextInput {
+	if keymap == nil {
+		keymap = EmacsKeymap{}
+	}
+	ti.keymap = keymap
+	return ti
+}
+
 // SetValue sets the input value
 func (ti *TextInput) SetValue(value string) *TextInput {
 	ti.value = []rune(value)
@@ -54,6 +74,16 @@ func (ti *TextInput) Value() string {
 	return string(ti.value)
 }
 
+// FieldValue returns the text, for forms
+func (ti *TextInput) FieldValue() string {
+	return ti.Value()
+}
+
+// SetFieldValue sets the text, for forms
+func (ti *TextInput) SetFieldValue(value string) {
+	ti.SetValue(value)
+}
+
 // SetPlaceholder sets placeholder text
 func (ti *TextInput) SetPlaceholder(placeholder string) *TextInput {
 	ti.placeholder = placeholder
@@ -72,6 +102,20 @@ func (ti *TextInput) SetMask(mask rune) *TextInput {
 	return ti
 }
 
+// SetTabInserts makes Tab insert indentation instead of being left for the
+// parent to move focus with
+func (ti *TextInput) SetTabInserts(inserts bool) *TextInput {
+	ti.tabInserts = inserts
+	return ti
+}
+
+// SetTabWidth sets how many spaces Tab inserts (4 by default), or 0 to
+// insert a tab character
+func (ti *TextInput) SetTabWidth(width int) *TextInput {
+	ti.tabWidth = width
+	return ti
+}
+
 // SetStyle sets the normal style
 func (ti *TextInput) SetStyle(style terminal.Style) *TextInput {
 	ti.style = style
@@ -90,6 +134,23 @@ func (ti *TextInput) OnChange(fn func(string)) *TextInput {
 	return ti
 }
 
+// SetChangeDebounce delays the change callback until typing has paused for d
+// Pending changes are delivered by Tick; Value always returns the current text
+func (ti *TextInput) SetChangeDebounce(d time.Duration) *TextInput {
+	ti.debounce.delay = d
+	return ti
+}
+
+// Tick delivers a debounced change once typing has paused
+// Returns true if the change callback was called
+func (ti *TextInput) Tick(now time.Time) bool {
+	if !ti.debounce.ready(now) {
+		return false
+	}
+	ti.callOnChange()
+	return true
+}
+
 // OnSubmit sets the submit callback (Enter key)
 func (ti *TextInput) OnSubmit(fn func(string)) *TextInput {
 	ti.onSubmit = fn
@@ -98,7 +159,8 @@ func (ti *TextInput) OnSubmit(fn func(string)) *TextInput {
 
 // Render draws the text input
 func (ti *TextInput) Render(buf *screen.Buffer, bounds layout.Rect) {
-	if !ti.visible {
+	ti.bounds = bounds
+	if !ti.visible || bounds.IsEmpty() {
 		return
 	}
 
@@ -169,94 +231,137 @@ func (ti *TextInput) HandleEvent(event input.Event) bool {
 	if !ok {
 		return false
 	}
+	action := ti.keymap.Action(keyEvent)
+	if action != EditInsert {
+		ti.history.endGroup()
+	}
 
-	switch keyEvent.Key {
-	case input.KeyRune:
+	switch action {
+	case EditNone, EditUp, EditDown:
+		return false
+	case EditInsert:
 		ti.insert(keyEvent.Rune)
-		return true
-
-	case input.KeyBackspace:
+	case EditLeft:
+		ti.cursorLeft()
+	case EditRight:
+		ti.cursorRight()
+	case EditWordLeft:
+		ti.wordLeft()
+	case EditWordRight:
+		ti.wordRight()
+	case EditHome:
+		ti.cursor = 0
+		ti.updateOffset()
+	case EditEnd:
+		ti.cursor = len(ti.value)
+		ti.updateOffset()
+	case EditBackspace:
 		ti.backspace()
-		return true
-
-	case input.KeyDelete:
+	case EditDelete:
 		ti.delete()
-		return true
-
-	case input.KeyLeft:
-		if keyEvent.IsCtrl() {
-			ti.wordLeft()
-		} else {
-			ti.cursorLeft()
+	case EditKillToEnd:
+		ti.record(false)
+		ti.value = ti.value[:ti.cursor]
+		ti.notifyChange()
+	case EditKillToStart:
+		ti.record(false)
+		ti.value = ti.value[ti.cursor:]
+		ti.cursor = 0
+		ti.updateOffset()
+		ti.notifyChange()
+	case EditKillWord:
+		ti.deleteWord()
+	case EditUndo:
+		ti.Undo()
+	case EditRedo:
+		ti.Redo()
+	case EditTab:
+		if !ti.tabInserts {
+			return false
 		}
-		return true
-
-	case input.KeyRight:
-		if keyEvent.IsCtrl() {
-			ti.wordRight()
+		if ti.tabWidth > 0 {
+			ti.insertString(strings.Repeat(" ", ti.tabWidth))
 		} else {
-			ti.cursorRight()
+			ti.insert('\t')
+		}
+	case EditSubmit:
+		if ti.debounce.flush() {
+			ti.callOnChange()
 		}
-		return true
-
-	case input.KeyHome:
-		ti.cursor = 0
-		ti.updateOffset()
-		return true
-
-	case input.KeyEnd:
-		ti.cursor = len(ti.value)
-		ti.updateOffset()
-		return true
-
-	case input.KeyEnter:
 		if ti.onSubmit != nil {
 			ti.onSubmit(string(ti.value))
 		}
-		return true
-	}
-
-	// Handle Ctrl+key
-	if keyEvent.IsCtrl() && keyEvent.Key == input.KeyRune {
-		switch keyEvent.Rune {
-		case 'a': // Ctrl+A: home
-			ti.cursor = 0
-			ti.updateOffset()
-			return true
-		case 'e': // Ctrl+E: end
-			ti.cursor = len(ti.value)
-			ti.updateOffset()
-			return true
-		case 'k': // Ctrl+K: kill to end
-			ti.value = ti.value[:ti.cursor]
-			ti.notifyChange()
-			return true
-		case 'u': // Ctrl+U: kill to start
-			ti.value = ti.value[ti.cursor:]
-			ti.cursor = 0
-			ti.updateOffset()
-			ti.notifyChange()
-			return true
-		case 'w': // Ctrl+W: kill word
-			ti.deleteWord()
-			return true
-		}
 	}
+	return true
+}
+
+// CanUndo returns true if there is an edit to undo
+func (ti *TextInput) CanUndo() bool {
+	return len(ti.history.undo) > 0
+}
+
+// CanRedo returns true if there is an undone edit to redo
+func (ti *TextInput) CanRedo() bool {
+	return len(ti.history.redo) > 0
+}
+
+// Undo reverts the last edit, a run of typing counting as one
+func (ti *TextInput) Undo() *TextInput {
+	if s, ok := ti.history.undoFrom(ti.snapshot()); ok {
+		ti.restore(s)
+	}
+	return ti
+}
+
+// Redo reapplies the last undone edit
+func (ti *TextInput) Redo() *TextInput {
+	if s, ok := ti.history.redoFrom(ti.snapshot()); ok {
+		ti.restore(s)
+	}
+	return ti
+}
+
+// snapshot returns the current text and cursor
+func (ti *TextInput) snapshot() textSnapshot {
+	return textSnapshot{value: ti.value, cursor: ti.cursor}
+}
+
+// restore sets the text and cursor from a snapshot
+func (ti *TextInput) restore(s textSnapshot) {
+	ti.value = s.value
+	ti.cursor = s.cursor
+	ti.updateOffset()
+	ti.notifyChange()
+}
 
-	return false
+// record saves the state before an edit for undo
+func (ti *TextInput) record(typing bool) {
+	ti.history.record(ti.snapshot(), typing)
 }
 
 // insert inserts a character at the cursor
 func (ti *TextInput) insert(r rune) {
+	ti.record(true)
 	ti.value = append(ti.value[:ti.cursor], append([]rune{r}, ti.value[ti.cursor:]...)...)
 	ti.cursor++
 	ti.updateOffset()
 	ti.notifyChange()
 }
 
+// insertString inserts s at the cursor as a single change
+func (ti *TextInput) insertString(s string) {
+	ti.record(false)
+	runes := []rune(s)
+	ti.value = append(ti.value[:ti.cursor], append(runes, ti.value[ti.cursor:]...)...)
+	ti.cursor += len(runes)
+	ti.updateOffset()
+	ti.notifyChange()
+}
+
 // backspace deletes the character before the cursor
 func (ti *TextInput) backspace() {
 	if ti.cursor > 0 {
+		ti.record(false)
 		ti.value = append(ti.value[:ti.cursor-1], ti.value[ti.cursor:]...)
 		ti.cursor--
 		ti.updateOffset()
@@ -267,6 +372,7 @@ func (ti *TextInput) backspace() {
 // delete deletes the character at the cursor
 func (ti *TextInput) delete() {
 	if ti.cursor < len(ti.value) {
+		ti.record(false)
 		ti.value = append(ti.value[:ti.cursor], ti.value[ti.cursor+1:]...)
 		ti.notifyChange()
 	}
@@ -320,6 +426,7 @@ func (ti *TextInput) deleteWord() {
 		return
 	}
 
+	ti.record(false)
 	end := ti.cursor
 	// Skip spaces
 	for ti.cursor > 0 && ti.value[ti.cursor-1] == ' ' {
@@ -351,8 +458,15 @@ func (ti *TextInput) updateOffset() {
 	}
 }
 
-// notifyChange calls the change callback
+// notifyChange calls the change callback, unless it is debounced
 func (ti *TextInput) notifyChange() {
+	if ti.debounce.change() {
+		ti.callOnChange()
+	}
+}
+
+// callOnChange calls the change callback
+func (ti *TextInput) callOnChange() {
 	if ti.onChange != nil {
 		ti.onChange(string(ti.value))
 	}
@@ -368,3 +482,12 @@ func (ti *TextInput) MinSize() layout.Size {
 	return layout.NewSize(5, 1)
 }
 
+// AccessibleDescription describes the input and its value, masked if the
+// input has a mask
+func (ti *TextInput) AccessibleDescription() string {
+	value := string(ti.value)
+	if ti.mask != 0 {
+		value = strings.Repeat(string(ti.mask), len(ti.value))
+	}
+	return "TextInput value=" + quote(value) + focusState(ti)
+}
@@ -0,0 +1,87 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// typeText sends a key event for each rune of s to w
+func typeText(w Widget, s string) {
+	for _, r := range s {
+		w.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: r})
+	}
+}
+
+func TestTextInputDebouncedChange(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ti := NewTextInput().SetChangeDebounce(100 * time.Millisecond)
+	ti.debounce.now = func() time.Time { return now }
+	ti.SetFocused(true)
+	var changes []string
+	ti.OnChange(func(value string) { changes = append(changes, value) })
+
+	for _, r := range "abc" {
+		typeText(ti, string(r))
+		now = now.Add(30 * time.Millisecond)
+		ti.Tick(now)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("changes %q reported while typing", changes)
+	}
+	if ti.Tick(now.Add(50 * time.Millisecond)) {
+		t.Fatal("change reported before the quiet period")
+	}
+	if !ti.Tick(now.Add(100*time.Millisecond)) || len(changes) != 1 || changes[0] != "abc" {
+		t.Fatalf("changes = %q after the quiet period, want [abc]", changes)
+	}
+	if ti.Tick(now.Add(time.Second)) || len(changes) != 1 {
+		t.Errorf("changes = %q, want the change reported once", changes)
+	}
+}
+
+func TestSearchDebouncedChange(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewSearch().SetChangeDebounce(100 * time.Millisecond)
+	s.debounce.now = func() time.Time { return now }
+	s.SetFocused(true)
+	var changes []string
+	s.SetOnChange(func(query string) { changes = append(changes, query) })
+
+	typeText(s, "go")
+	if s.Tick(now.Add(99*time.Millisecond)) || len(changes) != 0 {
+		t.Fatalf("changes = %q before the quiet period", changes)
+	}
+	if !s.Tick(now.Add(100*time.Millisecond)) || len(changes) != 1 || changes[0] != "go" {
+		t.Errorf("changes = %q, want [go]", changes)
+	}
+}
+
+func TestTextInputTab(t *testing.T) {
+	tab := input.KeyEvent{Key: input.KeyTab}
+	tests := []struct {
+		name    string
+		inserts bool
+		width   int
+		used    bool
+		want    string
+	}{
+		{"propagates by default", false, 4, false, "ab"},
+		{"spaces", true, 4, true, "a    b"},
+		{"two spaces", true, 2, true, "a  b"},
+		{"tab character", true, 0, true, "a\tb"},
+	}
+	for _, tt := range tests {
+		ti := NewTextInput().SetTabInserts(tt.inserts).SetTabWidth(tt.width)
+		ti.SetFocused(true)
+		typeText(ti, "a")
+		if used := ti.HandleEvent(tab); used != tt.used {
+			t.Errorf("%s: HandleEvent(Tab) = %v, want %v", tt.name, used, tt.used)
+		}
+		typeText(ti, "b")
+		if got := ti.Value(); got != tt.want {
+			t.Errorf("%s: value = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package widget
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// defaultTypeAheadTimeout is how long typed letters keep building a prefix
+const defaultTypeAheadTimeout = time.Second
+
+// typeAhead collects typed letters into a prefix for jumping to items
+// The prefix starts over once typing pauses for the timeout, checked on the
+// next letter and on app ticks.
+type typeAhead struct {
+	enabled  bool
+	timeout  time.Duration
+	prefix   string
+	lastType time.Time
+	now      func() time.Time
+}
+
+// newTypeAhead creates a disabled type-ahead with the default timeout
+func newTypeAhead() typeAhead {
+	return typeAhead{timeout: defaultTypeAheadTimeout, now: time.Now}
+}
+
+// key adds the letter typed in e to the prefix
+// Returns the prefix, or "" if type-ahead is off or e isn't a letter
+func (t *typeAhead) key(e input.KeyEvent) string {
+	if !t.enabled || e.Key != input.KeyRune || e.Modifier&^input.ModShift != 0 || !unicode.IsPrint(e.Rune) || e.Rune == ' ' {
+		return ""
+	}
+	now := t.now()
+	t.expire(now)
+	t.prefix += string(unicode.ToLower(e.Rune))
+	t.lastType = now
+	return t.prefix
+}
+
+// expire clears the prefix if typing has paused for the timeout at now
+func (t *typeAhead) expire(now time.Time) {
+	if t.prefix != "" && now.Sub(t.lastType) >= t.timeout {
+		t.prefix = ""
+	}
+}
+
+// find returns the first of count items, starting after current and
+// wrapping around, whose text starts with prefix, ignoring case, or -1
+// A longer prefix also matches the current item, so typing on refines the
+// match instead of moving past it.
+func (t *typeAhead) find(prefix string, current, count int, text func(i int) string) int {
+	start := current + 1
+	if len([]rune(prefix)) > 1 {
+		start = current
+	}
+	for n := 0; n < count; n++ {
+		i := ((start+n)%count + count) % count
+		if strings.HasPrefix(strings.ToLower(text(i)), prefix) {
+			return i
+		}
+	}
+	return -1
+}
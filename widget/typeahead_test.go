@@ -0,0 +1,108 @@
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// typeKey returns the key event for typing r
+func typeKey(r rune) input.KeyEvent {
+	return input.KeyEvent{Key: input.KeyRune, Rune: r}
+}
+
+func newTypeAheadList(now *time.Time) *List {
+	l := NewList().SetStrings([]string{"apple", "banana", "blueberry", "cherry", "Blackberry"}).SetTypeAhead(true)
+	l.typeAhead.now = func() time.Time { return *now }
+	l.SetFocused(true)
+	return l
+}
+
+func TestListTypeAheadJumps(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newTypeAheadList(&now)
+
+	// Repeating a letter moves on to the next match, wrapping around
+	for _, want := range []int{1, 2, 4} {
+		now = now.Add(2 * time.Second)
+		l.HandleEvent(typeKey('b'))
+		if l.Cursor() != want {
+			t.Errorf("cursor after b = %d, want %d", l.Cursor(), want)
+		}
+	}
+	now = now.Add(2 * time.Second)
+	l.HandleEvent(typeKey('B'))
+	if l.Cursor() != 1 {
+		t.Errorf("cursor after wrapping = %d, want 1", l.Cursor())
+	}
+}
+
+func TestListTypeAheadPrefix(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newTypeAheadList(&now)
+	for _, r := range "bla" {
+		l.HandleEvent(typeKey(r))
+		now = now.Add(100 * time.Millisecond)
+	}
+	if l.Cursor() != 4 {
+		t.Errorf("cursor after bla = %d, want 4", l.Cursor())
+	}
+	l.HandleEvent(typeKey('z'))
+	if l.Cursor() != 4 {
+		t.Errorf("cursor after a failed match = %d, want 4", l.Cursor())
+	}
+}
+
+func TestListTypeAheadTimeout(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newTypeAheadList(&now)
+	l.HandleEvent(typeKey('b'))
+
+	// A tick after the pause ends the search, so c starts a new one
+	now = now.Add(time.Second)
+	l.Tick(now)
+	if l.typeAhead.prefix != "" {
+		t.Errorf("prefix after timeout = %q, want empty", l.typeAhead.prefix)
+	}
+	l.HandleEvent(typeKey('c'))
+	if l.Cursor() != 3 {
+		t.Errorf("cursor after c = %d, want 3", l.Cursor())
+	}
+
+	// Without a pause, the next letter extends the prefix
+	l.HandleEvent(typeKey('x'))
+	if l.typeAhead.prefix != "cx" {
+		t.Errorf("prefix = %q, want %q", l.typeAhead.prefix, "cx")
+	}
+}
+
+func TestMenuTypeAhead(t *testing.T) {
+	now := time.Unix(0, 0)
+	m := NewMenu().SetShowBorder(false).SetItems([]*MenuItem{
+		{Label: "Open"}, {Label: "Save"}, {Label: "Save As"}, {Label: "Quit"},
+	}).SetTypeAhead(true)
+	m.typeAhead.now = func() time.Time { return now }
+	m.SetFocused(true)
+
+	for _, r := range "sa" {
+		m.HandleEvent(typeKey(r))
+	}
+	if m.Selected() != 1 {
+		t.Errorf("Selected() after sa = %d, want 1", m.Selected())
+	}
+	now = now.Add(time.Second)
+	m.HandleEvent(typeKey('q'))
+	if m.Selected() != 3 {
+		t.Errorf("Selected() after q = %d, want 3", m.Selected())
+	}
+}
+
+func TestTypeAheadDisabled(t *testing.T) {
+	l := NewList().SetStrings([]string{"a", "b"})
+	l.SetFocused(true)
+	l.HandleEvent(typeKey('b'))
+	if l.Cursor() != 0 {
+		t.Errorf("cursor = %d, want 0 without type-ahead", l.Cursor())
+	}
+}
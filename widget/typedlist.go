@@ -0,0 +1,101 @@
+package widget
+
+import "fmt"
+
+// TypedList is a List of values of type T, shown through an item text
+// function, whose callbacks receive the values themselves
+// Scrolling, selection and drawing are the embedded List's; its styling
+// and behavior setters can be called directly.
+type TypedList[T any] struct {
+	*List
+	values   []T
+	itemText func(T) string
+}
+
+// NewTypedList creates a typed list showing values with fmt.Sprint
+func NewTypedList[T any]() *TypedList[T] {
+	return &TypedList[T]{
+		List:     NewList(),
+		itemText: func(v T) string { return fmt.Sprint(v) },
+	}
+}
+
+// SetItems sets the values shown in the list
+func (l *TypedList[T]) SetItems(values []T) *TypedList[T] {
+	l.values = values
+	l.refresh()
+	return l
+}
+
+// Items returns the values
+func (l *TypedList[T]) Items() []T {
+	return l.values
+}
+
+// SetItemText sets how a value is shown
+func (l *TypedList[T]) SetItemText(fn func(T) string) *TypedList[T] {
+	l.itemText = fn
+	l.refresh()
+	return l
+}
+
+// refresh rebuilds the list items from the values
+func (l *TypedList[T]) refresh() {
+	items := make([]ListItem, len(l.values))
+	for i, v := range l.values {
+		items[i] = ListItem{Text: l.itemText(v), Value: v}
+	}
+	l.List.SetItems(items)
+}
+
+// CursorItem returns the value under the cursor, or false if the list is
+// empty
+func (l *TypedList[T]) CursorItem() (T, bool) {
+	i := l.Cursor()
+	if i < 0 || i >= len(l.values) {
+		var zero T
+		return zero, false
+	}
+	return l.values[i], true
+}
+
+// SelectedItems returns the selected values
+func (l *TypedList[T]) SelectedItems() []T {
+	selected := make([]T, 0, len(l.Selected()))
+	for _, i := range l.Selected() {
+		if i >= 0 && i < len(l.values) {
+			selected = append(selected, l.values[i])
+		}
+	}
+	return selected
+}
+
+// OnHighlight sets the callback for the cursor moving to another value
+func (l *TypedList[T]) OnHighlight(fn func(index int, item T)) *TypedList[T] {
+	l.List.OnHighlight(l.typed(fn))
+	return l
+}
+
+// OnSelect sets the callback for a value being selected or deselected
+func (l *TypedList[T]) OnSelect(fn func(index int, item T)) *TypedList[T] {
+	l.List.OnSelect(l.typed(fn))
+	return l
+}
+
+// OnActivate sets the callback for Enter or a double-click on a value
+func (l *TypedList[T]) OnActivate(fn func(index int, item T)) *TypedList[T] {
+	l.List.OnActivate(l.typed(fn))
+	return l
+}
+
+// typed adapts a callback on values to one on list items
+func (l *TypedList[T]) typed(fn func(int, T)) func(int, ListItem) {
+	if fn == nil {
+		return nil
+	}
+	return func(index int, _ ListItem) {
+		if index >= 0 && index < len(l.values) {
+			fn(index, l.values[index])
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package widget
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+type fruit struct {
+	name  string
+	price int
+}
+
+func TestTypedListCallbacksGetValues(t *testing.T) {
+	fruits := []fruit{{"apple", 3}, {"banana", 1}, {"cherry", 7}}
+	var highlighted, selected, activated []fruit
+	l := NewTypedList[fruit]().
+		SetItems(fruits).
+		SetItemText(func(f fruit) string { return f.name }).
+		OnHighlight(func(_ int, f fruit) { highlighted = append(highlighted, f) }).
+		OnSelect(func(_ int, f fruit) { selected = append(selected, f) }).
+		OnActivate(func(_ int, f fruit) { activated = append(activated, f) })
+	l.SetFocused(true)
+
+	if got := renderWidget(l, 8, 3).ToString(); got != "apple\nbanana\ncherry" {
+		t.Errorf("render = %q, want the item texts", got)
+	}
+	l.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	l.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: ' '})
+	if got := l.SelectedItems(); !slices.Equal(got, []fruit{fruits[1]}) {
+		t.Errorf("SelectedItems() = %v, want banana", got)
+	}
+	l.HandleEvent(input.KeyEvent{Key: input.KeyDown})
+	l.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+
+	if !slices.Equal(highlighted, []fruit{fruits[1], fruits[2]}) {
+		t.Errorf("OnHighlight got %v, want banana and cherry", highlighted)
+	}
+	if !slices.Equal(selected, []fruit{fruits[1]}) {
+		t.Errorf("OnSelect got %v, want banana", selected)
+	}
+	if !slices.Equal(activated, []fruit{fruits[2]}) {
+		t.Errorf("OnActivate got %v, want cherry", activated)
+	}
+	if got, ok := l.CursorItem(); !ok || got != fruits[2] {
+		t.Errorf("CursorItem() = %v, %v, want cherry, true", got, ok)
+	}
+}
+
+func TestTypedListDefaultText(t *testing.T) {
+	l := NewTypedList[int]().SetItems([]int{4, 2})
+	if got := renderWidget(l, 3, 2).ToString(); got != "4\n2" {
+		t.Errorf("render = %q, want %q", got, "4\n2")
+	}
+	if _, ok := NewTypedList[int]().CursorItem(); ok {
+		t.Error("CursorItem() on an empty list = true")
+	}
+}
@@ -0,0 +1,70 @@
+package widget
+
+import "slices"
+
+// defaultUndoLimit is how many edits can be undone by default
+const defaultUndoLimit = 100
+
+// textSnapshot is the text and cursor position of an editor
+type textSnapshot struct {
+	value  []rune
+	cursor int
+}
+
+// editHistory holds the undo and redo stacks of a text editor
+// A run of typed characters is undone as one edit.
+type editHistory struct {
+	undo   []textSnapshot
+	redo   []textSnapshot
+	limit  int
+	typing bool // The last edit was typing, so more typing joins it
+}
+
+// newEditHistory creates an empty history with the default limit
+func newEditHistory() editHistory {
+	return editHistory{limit: defaultUndoLimit}
+}
+
+// record saves the state before an edit, clearing the redo stack
+// Typing right after typing joins the previous edit instead.
+func (h *editHistory) record(before textSnapshot, typing bool) {
+	joined := typing && h.typing
+	h.typing = typing
+	h.redo = nil
+	if joined {
+		return
+	}
+	before.value = slices.Clone(before.value)
+	h.undo = append(h.undo, before)
+	if h.limit > 0 && len(h.undo) > h.limit {
+		h.undo = slices.Delete(h.undo, 0, len(h.undo)-h.limit)
+	}
+}
+
+// endGroup stops further typing from joining the last edit
+func (h *editHistory) endGroup() {
+	h.typing = false
+}
+
+// step pops a state from one stack, pushing current onto the other
+func (h *editHistory) step(from, to *[]textSnapshot, current textSnapshot) (textSnapshot, bool) {
+	h.typing = false
+	if len(*from) == 0 {
+		return textSnapshot{}, false
+	}
+	last := (*from)[len(*from)-1]
+	*from = (*from)[:len(*from)-1]
+	current.value = slices.Clone(current.value)
+	*to = append(*to, current)
+	return last, true
+}
+
+// undoFrom returns the state before the last edit, given the current one
+func (h *editHistory) undoFrom(current textSnapshot) (textSnapshot, bool) {
+	return h.step(&h.undo, &h.redo, current)
+}
+
+// redoFrom returns the state after the last undone edit
+func (h *editHistory) redoFrom(current textSnapshot) (textSnapshot, bool) {
+	return h.step(&h.redo, &h.undo, current)
+}
@@ -0,0 +1,102 @@
+package widget
+
+import (
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+var (
+	ctrlZ      = input.KeyEvent{Key: input.KeyRune, Rune: 'z', Modifier: input.ModCtrl}
+	ctrlShiftZ = input.KeyEvent{Key: input.KeyRune, Rune: 'z', Modifier: input.ModCtrl | input.ModShift}
+	ctrlY      = input.KeyEvent{Key: input.KeyRune, Rune: 'y', Modifier: input.ModCtrl}
+)
+
+func TestTextInputUndoRedo(t *testing.T) {
+	ti := NewTextInput()
+	ti.SetFocused(true)
+	if ti.CanUndo() || ti.CanRedo() {
+		t.Fatal("new input can undo or redo")
+	}
+
+	// A run of typing is one edit; moving the cursor ends it
+	typeText(ti, "hello")
+	ti.HandleEvent(input.KeyEvent{Key: input.KeyLeft})
+	typeText(ti, "XY")
+	if ti.Value() != "hellXYo" {
+		t.Fatalf("value = %q, want %q", ti.Value(), "hellXYo")
+	}
+
+	ti.HandleEvent(ctrlZ)
+	if ti.Value() != "hello" || ti.cursor != 4 {
+		t.Errorf("after undo: value, cursor = %q, %d, want %q, 4", ti.Value(), ti.cursor, "hello")
+	}
+	ti.HandleEvent(ctrlZ)
+	if ti.Value() != "" || ti.cursor != 0 || ti.CanUndo() {
+		t.Errorf("after second undo: value, cursor = %q, %d, want empty with nothing to undo", ti.Value(), ti.cursor)
+	}
+	ti.HandleEvent(ctrlY)
+	if ti.Value() != "hello" || ti.cursor != 4 {
+		t.Errorf("after redo: value, cursor = %q, %d, want %q, 4", ti.Value(), ti.cursor, "hello")
+	}
+	ti.HandleEvent(ctrlShiftZ)
+	if ti.Value() != "hellXYo" || ti.cursor != 6 {
+		t.Errorf("after Ctrl+Shift+Z: value, cursor = %q, %d, want %q, 6", ti.Value(), ti.cursor, "hellXYo")
+	}
+}
+
+func TestTextInputEditClearsRedo(t *testing.T) {
+	ti := NewTextInput()
+	ti.SetFocused(true)
+	typeText(ti, "ab")
+	ti.HandleEvent(input.KeyEvent{Key: input.KeyBackspace})
+	ti.HandleEvent(ctrlZ)
+	if !ti.CanRedo() {
+		t.Fatal("CanRedo() after undo = false")
+	}
+	typeText(ti, "c")
+	if ti.CanRedo() {
+		t.Error("CanRedo() after a new edit = true")
+	}
+	ti.HandleEvent(ctrlZ)
+	if ti.Value() != "ab" {
+		t.Errorf("value = %q, want %q", ti.Value(), "ab")
+	}
+}
+
+func TestTextAreaUndoRedo(t *testing.T) {
+	ta := NewTextArea()
+	ta.SetFocused(true)
+	typeText(ta, "one")
+	ta.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	typeText(ta, "two")
+	if ta.Value() != "one\ntwo" {
+		t.Fatalf("value = %q, want %q", ta.Value(), "one\ntwo")
+	}
+
+	ta.HandleEvent(ctrlZ)
+	if line, col := ta.Cursor(); ta.Value() != "one\n" || line != 1 || col != 0 {
+		t.Errorf("after undo: value = %q at %d:%d, want %q at 1:0", ta.Value(), line, col, "one\n")
+	}
+	ta.HandleEvent(ctrlZ)
+	ta.HandleEvent(ctrlZ)
+	if ta.Value() != "" || ta.CanUndo() {
+		t.Errorf("after undoing everything: value = %q, CanUndo() = %v", ta.Value(), ta.CanUndo())
+	}
+	ta.HandleEvent(ctrlY)
+	ta.HandleEvent(ctrlY)
+	if line, col := ta.Cursor(); ta.Value() != "one\n" || line != 1 || col != 0 {
+		t.Errorf("after redo: value = %q at %d:%d, want %q at 1:0", ta.Value(), line, col, "one\n")
+	}
+}
+
+func TestEditHistoryLimit(t *testing.T) {
+	h := newEditHistory()
+	h.limit = 3
+	for i := range 5 {
+		h.record(textSnapshot{value: []rune{rune('a' + i)}}, false)
+	}
+	if len(h.undo) != 3 || string(h.undo[0].value) != "c" {
+		t.Errorf("undo stack = %d edits from %q, want 3 from %q", len(h.undo), string(h.undo[0].value), "c")
+	}
+}
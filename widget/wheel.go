@@ -0,0 +1,60 @@
+package widget
+
+import (
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+)
+
+// defaultWheelStep is how many lines one wheel notch scrolls by default
+const defaultWheelStep = 3
+
+// wheelScroll holds the mouse wheel settings of a scrollable widget
+type wheelScroll struct {
+	step       int  // Lines scrolled per wheel notch
+	moveCursor bool // Move the cursor with the wheel instead of only scrolling
+}
+
+// newWheelScroll creates wheel settings that scroll without moving the cursor
+func newWheelScroll() wheelScroll {
+	return wheelScroll{step: defaultWheelStep}
+}
+
+// delta returns how many lines event scrolls by, negative for up, or 0 if
+// it isn't a wheel event over bounds
+// Event coordinates are relative to the top-left corner of bounds
+func (w wheelScroll) delta(event input.Event, bounds layout.Rect) int {
+	e, ok := event.(input.MouseEvent)
+	if !ok || e.X < 0 || e.Y < 0 || e.X >= bounds.Width || e.Y >= bounds.Height {
+		return 0
+	}
+	switch e.Button {
+	case input.MouseWheelUp:
+		return -w.step
+	case input.MouseWheelDown:
+		return w.step
+	}
+	return 0
+}
+
+// clampOffset limits a scroll offset so the view stays within total lines
+func clampOffset(offset, total, visible int) int {
+	return max(0, min(offset, total-visible))
+}
+
+// scrollNotifier reports a scrollable widget's offset to its OnScroll
+// callback whenever the offset changes
+type scrollNotifier struct {
+	fn   func(offset, visible, total int)
+	last int // Offset last reported
+}
+
+// notify calls the callback if offset differs from the last one reported
+func (s *scrollNotifier) notify(offset, visible, total int) {
+	if offset == s.last {
+		return
+	}
+	s.last = offset
+	if s.fn != nil {
+		s.fn(offset, visible, total)
+	}
+}
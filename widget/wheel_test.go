@@ -0,0 +1,96 @@
+package widget
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+// wheel returns a wheel event at (x, y), down or up
+func wheel(x, y int, down bool) input.MouseEvent {
+	button := input.MouseWheelUp
+	if down {
+		button = input.MouseWheelDown
+	}
+	return input.MouseEvent{X: x, Y: y, Button: button}
+}
+
+// numberedStrings returns n strings "s0", "s1", ...
+func numberedStrings(n int) []string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = "s" + strconv.Itoa(i)
+	}
+	return s
+}
+
+func TestWheelScrollsByStep(t *testing.T) {
+	list := NewList().SetStrings(numberedStrings(30)).SetHeight(10)
+	table := newTestTable([]TableColumn{{Title: "A", Flex: 1}}, numberedRows(30))
+	menu := numberedMenu(30)
+	view := NewScrollView(NewText(strings.Repeat("line\n", 29) + "line"))
+
+	tests := []struct {
+		name   string
+		w      Widget
+		offset func() int
+		step   func(int)
+	}{
+		{"List", list, func() int { return list.offset }, func(n int) { list.SetWheelStep(n) }},
+		{"Table", table, func() int { return table.offset }, func(n int) { table.SetWheelStep(n) }},
+		{"Menu", menu, func() int { return menu.offset }, func(n int) { menu.SetWheelStep(n) }},
+		{"ScrollView", view, view.Offset, func(n int) { view.SetWheelStep(n) }},
+	}
+	for _, tt := range tests {
+		renderWidget(tt.w, 12, 10)
+		if !tt.w.HandleEvent(wheel(1, 1, true)) || tt.offset() != defaultWheelStep {
+			t.Errorf("%s: offset %d after wheel down, want %d", tt.name, tt.offset(), defaultWheelStep)
+		}
+		tt.step(5)
+		tt.w.HandleEvent(wheel(1, 1, true))
+		if tt.offset() != defaultWheelStep+5 {
+			t.Errorf("%s: offset %d after wheel down by 5, want %d", tt.name, tt.offset(), defaultWheelStep+5)
+		}
+		tt.w.HandleEvent(wheel(1, 1, false))
+		if tt.offset() != defaultWheelStep {
+			t.Errorf("%s: offset %d after wheel up, want %d", tt.name, tt.offset(), defaultWheelStep)
+		}
+		if tt.w.HandleEvent(wheel(20, 1, true)) || tt.offset() != defaultWheelStep {
+			t.Errorf("%s: wheel outside the bounds scrolled to %d", tt.name, tt.offset())
+		}
+		for i := 0; i < 10; i++ {
+			tt.w.HandleEvent(wheel(1, 1, true))
+		}
+		if tt.offset() != 20 {
+			t.Errorf("%s: offset %d after scrolling past the end, want 20", tt.name, tt.offset())
+		}
+	}
+
+	if list.Cursor() != 0 || table.SelectedRow() != 0 || menu.Selected() != 0 {
+		t.Error("wheel moved the selection without SetWheelMovesCursor")
+	}
+}
+
+func TestWheelMovesCursor(t *testing.T) {
+	list := NewList().SetStrings(numberedStrings(30)).SetHeight(10).SetWheelMovesCursor(true)
+	renderWidget(list, 12, 10)
+	list.HandleEvent(wheel(1, 1, true))
+	list.HandleEvent(wheel(1, 1, true))
+	if list.Cursor() != 6 {
+		t.Errorf("cursor %d after two wheel notches, want 6", list.Cursor())
+	}
+	list.HandleEvent(wheel(1, 1, false))
+	if list.Cursor() != 3 {
+		t.Errorf("cursor %d after wheel up, want 3", list.Cursor())
+	}
+}
+
+func TestScrollViewLeavesWheelWhenContentFits(t *testing.T) {
+	view := NewScrollView(NewText("short"))
+	renderWidget(view, 12, 10)
+	if view.HandleEvent(wheel(1, 1, true)) || view.Offset() != 0 {
+		t.Error("ScrollView used the wheel though its content fits")
+	}
+}
@@ -1,9 +1,12 @@
 package widget
 
 import (
+	"time"
+
 	"github.com/agiles231/gotui/input"
 	"github.com/agiles231/gotui/layout"
 	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
 )
 
 // Widget is the interface all UI components implement
@@ -31,11 +34,33 @@ type Widget interface {
 	IsInteractive() bool
 }
 
+// Ticker is implemented by widgets that update over time, such as animations
+type Ticker interface {
+	// Tick advances the widget to now
+	// Returns true if the widget needs a redraw
+	Tick(now time.Time) bool
+}
+
+// Valued is implemented by widgets holding a value a Form collects
+type Valued interface {
+	// FieldValue returns the current value as text
+	FieldValue() string
+}
+
+// ValueSetter is implemented by Valued widgets whose value a Form can reset
+type ValueSetter interface {
+	// SetFieldValue sets the value from text in the form FieldValue returns
+	SetFieldValue(value string)
+}
+
 // BaseWidget provides common functionality for widgets
 type BaseWidget struct {
 	focused     bool
 	interactive bool
 	visible     bool
+	bounds      layout.Rect // Bounds from the last render
+	onFocus     func()
+	onBlur      func()
 }
 
 // NewBaseWidget creates a new base widget
@@ -46,8 +71,38 @@ func NewBaseWidget() BaseWidget {
 }
 
 // SetFocused sets the focus state
+// OnFocus and OnBlur callbacks fire only when the state changes
 func (w *BaseWidget) SetFocused(focused bool) {
+	if w.focused == focused {
+		return
+	}
 	w.focused = focused
+	if focused && w.onFocus != nil {
+		w.onFocus()
+	} else if !focused && w.onBlur != nil {
+		w.onBlur()
+	}
+}
+
+// OnFocus sets the callback for gaining focus
+func (w *BaseWidget) OnFocus(fn func()) {
+	w.onFocus = fn
+}
+
+// OnBlur sets the callback for losing focus
+func (w *BaseWidget) OnBlur(fn func()) {
+	w.onBlur = fn
+}
+
+// Bounds returns the bounds the widget was last rendered in
+func (w *BaseWidget) Bounds() layout.Rect {
+	return w.bounds
+}
+
+// SetBounds records the bounds the widget is rendered in
+// Widgets embedding BaseWidget record their bounds at the start of Render
+func (w *BaseWidget) SetBounds(bounds layout.Rect) {
+	w.bounds = bounds
 }
 
 // IsFocused returns the focus state
@@ -75,6 +130,19 @@ func (w *BaseWidget) SetVisible(visible bool) {
 	w.visible = visible
 }
 
+// setVisibleRecursive sets the visibility of w and of every descendant
+// reachable through Children
+func setVisibleRecursive(w Widget, visible bool) {
+	if v, ok := w.(interface{ SetVisible(bool) }); ok {
+		v.SetVisible(visible)
+	}
+	if parent, ok := w.(interface{ Children() []Widget }); ok {
+		for _, child := range parent.Children() {
+			setVisibleRecursive(child, visible)
+		}
+	}
+}
+
 // Container is a widget that contains other widgets
 type Container interface {
 	Widget
@@ -87,10 +155,19 @@ type Container interface {
 }
 
 // FocusManager manages focus between widgets
+// Focus can be trapped to a subset of widgets, such as a dialog's, with
+// PushScope until the matching PopScope
 type FocusManager struct {
-	widgets       []Widget
-	focusedIndex  int
-	cycleFocus    bool
+	widgets      []Widget
+	focusedIndex int
+	cycleFocus   bool
+	scopes       []focusScope // Enclosing scopes, innermost last
+}
+
+// focusScope is a saved set of widgets and its focus, restored on PopScope
+type focusScope struct {
+	widgets      []Widget
+	focusedIndex int
 }
 
 // NewFocusManager creates a new focus manager
@@ -121,6 +198,16 @@ func (fm *FocusManager) Remove(w Widget) {
 	}
 }
 
+// Contains returns whether w is one of the managed widgets
+func (fm *FocusManager) Contains(w Widget) bool {
+	for _, widget := range fm.widgets {
+		if widget == w {
+			return true
+		}
+	}
+	return false
+}
+
 // Focus sets focus to a specific widget
 func (fm *FocusManager) Focus(w Widget) {
 	for i, widget := range fm.widgets {
@@ -183,6 +270,120 @@ func (fm *FocusManager) FocusPrev() {
 	fm.widgets[fm.focusedIndex].SetFocused(true)
 }
 
+// FocusDirection moves focus to the nearest widget in the direction of an
+// arrow key, judged by the bounds the widgets were last rendered in
+// Distance between centers is scored with off-axis offset weighted double,
+// so widgets in line with the focused one are preferred
+// Returns true if focus moved
+func (fm *FocusManager) FocusDirection(dir input.Key) bool {
+	current := fm.Focused()
+	if current == nil {
+		return false
+	}
+	from, ok := renderedBounds(current)
+	if !ok {
+		return false
+	}
+	fx, fy := from.Center()
+
+	best := -1
+	bestScore := 0
+	for i, w := range fm.widgets {
+		if i == fm.focusedIndex {
+			continue
+		}
+		if v, ok := w.(interface{ IsVisible() bool }); ok && !v.IsVisible() {
+			continue
+		}
+		bounds, ok := renderedBounds(w)
+		if !ok {
+			continue
+		}
+		x, y := bounds.Center()
+		dx, dy := x-fx, y-fy
+
+		// Distance along the direction and across it
+		var along, across int
+		switch dir {
+		case input.KeyRight:
+			along, across = dx, dy
+		case input.KeyLeft:
+			along, across = -dx, dy
+		case input.KeyDown:
+			along, across = dy, dx
+		case input.KeyUp:
+			along, across = -dy, dx
+		default:
+			return false
+		}
+		if along <= 0 {
+			continue
+		}
+		score := along*along + 4*across*across
+		if best < 0 || score < bestScore {
+			best, bestScore = i, score
+		}
+	}
+
+	if best < 0 {
+		return false
+	}
+	fm.Focus(fm.widgets[best])
+	return true
+}
+
+// renderedBounds returns the last rendered bounds of a widget, if known
+func renderedBounds(w Widget) (layout.Rect, bool) {
+	b, ok := w.(interface{ Bounds() layout.Rect })
+	if !ok || b.Bounds().IsEmpty() {
+		return layout.Rect{}, false
+	}
+	return b.Bounds(), true
+}
+
+// PushScope traps focus to widgets until PopScope, focusing the first
+// interactive one
+// Add, Remove and focus cycling apply to the active scope only
+func (fm *FocusManager) PushScope(widgets []Widget) {
+	if focused := fm.Focused(); focused != nil {
+		focused.SetFocused(false)
+	}
+	fm.scopes = append(fm.scopes, focusScope{widgets: fm.widgets, focusedIndex: fm.focusedIndex})
+
+	fm.widgets = nil
+	fm.focusedIndex = -1
+	for _, w := range widgets {
+		fm.Add(w)
+	}
+	if len(fm.widgets) > 0 {
+		fm.focusedIndex = 0
+		fm.widgets[0].SetFocused(true)
+	}
+}
+
+// PopScope ends the innermost scope and restores the focus it replaced
+func (fm *FocusManager) PopScope() {
+	if len(fm.scopes) == 0 {
+		return
+	}
+	if focused := fm.Focused(); focused != nil {
+		focused.SetFocused(false)
+	}
+
+	scope := fm.scopes[len(fm.scopes)-1]
+	fm.scopes = fm.scopes[:len(fm.scopes)-1]
+	fm.widgets = scope.widgets
+	fm.focusedIndex = scope.focusedIndex
+	if focused := fm.Focused(); focused != nil {
+		focused.SetFocused(true)
+	}
+}
+
+// ScopeDepth returns the number of pushed scopes
+func (fm *FocusManager) ScopeDepth() int {
+	return len(fm.scopes)
+}
+
 // Focused returns the currently focused widget
 func (fm *FocusManager) Focused() Widget {
 	if fm.focusedIndex >= 0 && fm.focusedIndex < len(fm.widgets) {
@@ -193,6 +394,11 @@ func (fm *FocusManager) Focused() Widget {
 
 // HandleEvent passes the event to the focused widget
 func (fm *FocusManager) HandleEvent(event input.Event) bool {
+	return fm.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx passes the event to the focused widget along with ctx
+func (fm *FocusManager) HandleEventCtx(ctx EventContext, event input.Event) bool {
 	// Handle tab for focus cycling
 	if keyEvent, ok := event.(input.KeyEvent); ok {
 		if keyEvent.Key == input.KeyTab {
@@ -207,9 +413,19 @@ func (fm *FocusManager) HandleEvent(event input.Event) bool {
 
 	// Pass to focused widget
 	if focused := fm.Focused(); focused != nil {
-		return focused.HandleEvent(event)
+		return HandleEventCtx(focused, ctx, event)
 	}
 
 	return false
 }
 
+// drawEmptyText draws a dim placeholder message centered within bounds
+func drawEmptyText(buf *screen.Buffer, bounds layout.Rect, text string, style terminal.Style) {
+	if text == "" || bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	text = screen.Truncate(text, bounds.Width, true)
+	x := bounds.X + layout.Align(screen.DisplayWidth(text), bounds.Width, layout.AlignCenter)
+	y := bounds.Y + layout.Align(1, bounds.Height, layout.AlignCenter)
+	buf.DrawString(x, y, bounds.Z, text, style.WithDim())
+}
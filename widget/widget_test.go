@@ -0,0 +1,175 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+)
+
+func TestEmptyTextPlaceholder(t *testing.T) {
+	list := NewList().SetEmptyText("Empty")
+	table := newTestTable([]TableColumn{{Title: "A", Flex: 1}}, nil).SetEmptyText("Empty")
+	menu := NewMenu().SetShowBorder(false).SetEmptyText("Empty")
+
+	for name, w := range map[string]Widget{"List": list, "Table": table} {
+		// Centered in a 9x3 area: columns 2-6 of the middle row
+		if got := renderWidget(w, 9, 3).ToString(); got != "\n  Empty\n" {
+			t.Errorf("empty %s rendered %q, want the placeholder centered", name, got)
+		}
+	}
+	// A menu is as tall as its content, so the placeholder takes one row
+	if got := renderWidget(menu, 9, 3).ToString(); got != "  Empty\n\n" {
+		t.Errorf("empty Menu rendered %q, want the placeholder centered on the first row", got)
+	}
+
+	list.SetStrings([]string{"one"})
+	table.SetRows([][]string{{"one"}})
+	menu.SetItems([]*MenuItem{{Label: "one"}})
+	for name, w := range map[string]Widget{"List": list, "Table": table, "Menu": menu} {
+		got := renderWidget(w, 9, 3).ToString()
+		if strings.Contains(got, "Empty") || !strings.Contains(got, "one") {
+			t.Errorf("%s with items rendered %q, want the items without the placeholder", name, got)
+		}
+	}
+}
+
+func TestFocusCallbacksFireOnTransitions(t *testing.T) {
+	first, second := NewButton("One"), NewButton("Two")
+	focuses, blurs := 0, 0
+	first.OnFocus(func() { focuses++ })
+	first.OnBlur(func() { blurs++ })
+
+	first.SetFocused(true)
+	first.SetFocused(true) // No-op
+	if focuses != 1 || blurs != 0 {
+		t.Fatalf("after focusing twice: %d focuses, %d blurs, want 1 and 0", focuses, blurs)
+	}
+	first.SetFocused(false)
+	first.SetFocused(false) // No-op
+	if focuses != 1 || blurs != 1 {
+		t.Fatalf("after blurring twice: %d focuses, %d blurs, want 1 and 1", focuses, blurs)
+	}
+
+	// The focus manager fires them as focus moves between widgets
+	fm := NewFocusManager()
+	fm.Add(first)
+	fm.Add(second)
+	fm.Focus(first)
+	fm.FocusNext()
+	fm.FocusNext()
+	if focuses != 3 || blurs != 2 {
+		t.Errorf("after moving focus away and back: %d focuses, %d blurs, want 3 and 2", focuses, blurs)
+	}
+}
+
+func TestHiddenContainers(t *testing.T) {
+	form := NewForm()
+	field := form.AddTextInput("Name", "")
+	tab := &Tab{
+		BaseWidget:       NewBaseWidget(),
+		widgetAndLayouts: []WidgetAndLayout{{bounds: layout.NewRect(0, 0, 0, 10, 1), widget: NewText("tab child")}},
+	}
+	search := NewSearchAndResults()
+	containers := map[string]interface {
+		Widget
+		SetVisibleRecursive(bool)
+		Children() []Widget
+	}{"Form": form, "Tab": tab, "SearchAndResults": search}
+
+	for name, c := range containers {
+		c.SetFocused(true)
+		c.SetVisibleRecursive(false)
+		for _, child := range c.Children() {
+			if v, ok := child.(interface{ IsVisible() bool }); ok && v.IsVisible() {
+				t.Errorf("%s: SetVisibleRecursive(false) left a child visible", name)
+			}
+		}
+		if got := renderWidget(c, 30, 20).ToString(); strings.TrimSpace(got) != "" {
+			t.Errorf("hidden %s rendered %q", name, got)
+		}
+		if c.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'x'}) {
+			t.Errorf("hidden %s consumed an event", name)
+		}
+	}
+	if field.Value() != "" {
+		t.Errorf("hidden form's input received %q", field.Value())
+	}
+
+	form.SetVisibleRecursive(true)
+	if !form.HandleEvent(input.KeyEvent{Key: input.KeyRune, Rune: 'x'}) || field.Value() != "x" {
+		t.Error("shown form's input didn't receive the key")
+	}
+}
+
+func TestFocusScopeTrapsAndRestores(t *testing.T) {
+	a, b := NewButton("A"), NewButton("B")
+	ok, cancel := NewButton("OK"), NewButton("Cancel")
+	fm := NewFocusManager()
+	fm.Add(a)
+	fm.Add(b)
+	fm.Focus(b)
+
+	fm.PushScope([]Widget{ok, cancel})
+	if fm.Focused() != ok || b.IsFocused() {
+		t.Fatal("PushScope did not move focus to the first scoped widget")
+	}
+	tab := input.KeyEvent{Key: input.KeyTab}
+	for i, want := range []Widget{cancel, ok, cancel} {
+		fm.HandleEvent(tab)
+		if fm.Focused() != want {
+			t.Fatalf("Tab %d focused %v, want it kept within the scope", i+1, fm.Focused())
+		}
+	}
+	if fm.ScopeDepth() != 1 {
+		t.Errorf("ScopeDepth = %d, want 1", fm.ScopeDepth())
+	}
+
+	fm.PopScope()
+	if fm.Focused() != b || !b.IsFocused() || cancel.IsFocused() {
+		t.Error("PopScope did not restore the previous focus")
+	}
+	fm.PopScope() // No scope left
+	if fm.Focused() != b || fm.ScopeDepth() != 0 {
+		t.Error("PopScope without a scope changed the focus")
+	}
+}
+
+func TestFocusDirection(t *testing.T) {
+	// A 2x2 grid of 10x3 buttons
+	grid := [2][2]*Button{}
+	fm := NewFocusManager()
+	for row := range grid {
+		for col := range grid[row] {
+			grid[row][col] = NewButton("B")
+			grid[row][col].SetBounds(layout.NewRect(col*10, row*3, 0, 10, 3))
+			fm.Add(grid[row][col])
+		}
+	}
+	topLeft, topRight := grid[0][0], grid[0][1]
+	bottomLeft, bottomRight := grid[1][0], grid[1][1]
+
+	tests := []struct {
+		from  *Button
+		dir   input.Key
+		want  *Button
+		moved bool
+	}{
+		{topLeft, input.KeyRight, topRight, true},
+		{topLeft, input.KeyDown, bottomLeft, true},
+		{topRight, input.KeyDown, bottomRight, true},
+		{bottomRight, input.KeyLeft, bottomLeft, true},
+		{bottomLeft, input.KeyUp, topLeft, true},
+		{topLeft, input.KeyLeft, topLeft, false},
+		{topLeft, input.KeyUp, topLeft, false},
+		{bottomRight, input.KeyEnter, bottomRight, false},
+	}
+	for _, tt := range tests {
+		fm.Focus(tt.from)
+		moved := fm.FocusDirection(tt.dir)
+		if moved != tt.moved || fm.Focused() != tt.want {
+			t.Errorf("FocusDirection(%v) = %v, focused %p; want %v, %p", tt.dir, moved, fm.Focused(), tt.moved, tt.want)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package widget
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/agiles231/gotui/input"
+	"github.com/agiles231/gotui/layout"
+	"github.com/agiles231/gotui/screen"
+	"github.com/agiles231/gotui/terminal"
+)
+
+// WizardStep is one page of a Wizard
+type WizardStep struct {
+	Title  string
+	Widget Widget
+	// Validate is run before leaving the step with Next or Finish, which
+	// are blocked while it returns an error. When nil, a Form step is
+	// checked for empty required fields.
+	Validate func() error
+}
+
+// validate runs the step's validation
+func (s WizardStep) validate() error {
+	if s.Validate != nil {
+		return s.Validate()
+	}
+	if form, ok := s.Widget.(*Form); ok {
+		if missing := form.Validate(); len(missing) > 0 {
+			return errors.New("Required: " + strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// Wizard walks through a sequence of steps with Back and Next buttons,
+// the last step having Finish instead of Next
+// It shows the current step under a "Step 2 of 4" indicator, with the
+// validation error, if any, above the buttons. Tab moves focus between the
+// step and the buttons, so a Form step is navigated with Up/Down.
+type Wizard struct {
+	BaseWidget
+	steps      []WizardStep
+	current    int
+	focus      int // Index into targets()
+	err        error
+	back       *Button
+	next       *Button
+	style      terminal.Style
+	titleStyle terminal.Style
+	errorStyle terminal.Style
+	onComplete func(values map[string]string)
+}
+
+// NewWizard creates a wizard with no steps
+func NewWizard() *Wizard {
+	w := &Wizard{
+		BaseWidget: NewBaseWidget(),
+		style:      terminal.DefaultStyle(),
+		titleStyle: terminal.DefaultStyle().WithBold(),
+		errorStyle: terminal.DefaultStyle().WithFG(terminal.ColorRed),
+	}
+	w.back = NewButton("Back").OnPress(w.Back)
+	w.next = NewButton("Next").OnPress(func() { w.Next() })
+	w.SetInteractive(true)
+	return w
+}
+
+// AddStep adds a step
+func (w *Wizard) AddStep(title string, widget Widget, validate func() error) *Wizard {
+	w.steps = append(w.steps, WizardStep{Title: title, Widget: widget, Validate: validate})
+	w.updateButtons()
+	return w
+}
+
+// Steps returns the steps
+func (w *Wizard) Steps() []WizardStep {
+	return w.steps
+}
+
+// Current returns the index of the current step
+func (w *Wizard) Current() int {
+	return w.current
+}
+
+// Err returns the error that blocked the last Next or Finish, or nil
+func (w *Wizard) Err() error {
+	return w.err
+}
+
+// SetStyle sets the style
+func (w *Wizard) SetStyle(style terminal.Style) *Wizard {
+	w.style = style
+	return w
+}
+
+// SetTitleStyle sets the style of the step indicator
+func (w *Wizard) SetTitleStyle(style terminal.Style) *Wizard {
+	w.titleStyle = style
+	return w
+}
+
+// SetErrorStyle sets the style of validation errors
+func (w *Wizard) SetErrorStyle(style terminal.Style) *Wizard {
+	w.errorStyle = style
+	return w
+}
+
+// OnComplete sets the callback for Finish, given the values of all steps
+func (w *Wizard) OnComplete(fn func(values map[string]string)) *Wizard {
+	w.onComplete = fn
+	return w
+}
+
+// Next validates the current step and moves to the next one, or finishes
+// on the last step
+// Returns false if validation failed
+func (w *Wizard) Next() bool {
+	if len(w.steps) == 0 {
+		return false
+	}
+	if w.err = w.steps[w.current].validate(); w.err != nil {
+		return false
+	}
+	if w.current == len(w.steps)-1 {
+		if w.onComplete != nil {
+			w.onComplete(w.Values())
+		}
+		return true
+	}
+	w.goTo(w.current + 1)
+	return true
+}
+
+// Back moves to the previous step without validating the current one
+func (w *Wizard) Back() {
+	if w.current > 0 {
+		w.err = nil
+		w.goTo(w.current - 1)
+	}
+}
+
+// goTo shows step index, focusing it
+func (w *Wizard) goTo(index int) {
+	w.setTargetFocus(false)
+	w.current = index
+	w.focus = 0
+	w.updateButtons()
+	w.setTargetFocus(w.focused)
+}
+
+// updateButtons labels Next as Finish on the last step
+func (w *Wizard) updateButtons() {
+	if w.current == len(w.steps)-1 {
+		w.next.SetLabel("Finish")
+	} else {
+		w.next.SetLabel("Next")
+	}
+}
+
+// Values returns the values of all steps
+// Form steps contribute their fields by label, with multiple values joined
+// by ", "; other Valued steps contribute their value by step title
+func (w *Wizard) Values() map[string]string {
+	values := make(map[string]string)
+	for _, step := range w.steps {
+		switch widget := step.Widget.(type) {
+		case *Form:
+			for label, v := range widget.Values() {
+				values[label] = strings.Join(v, ", ")
+			}
+		case Valued:
+			values[step.Title] = widget.FieldValue()
+		}
+	}
+	return values
+}
+
+// targets returns the focusable widgets in Tab order: the step, then Back
+// unless on the first step, then Next
+func (w *Wizard) targets() []Widget {
+	var targets []Widget
+	if len(w.steps) > 0 {
+		targets = append(targets, w.steps[w.current].Widget)
+	}
+	if w.current > 0 {
+		targets = append(targets, w.back)
+	}
+	return append(targets, w.next)
+}
+
+// setTargetFocus sets the focus of the focused target
+func (w *Wizard) setTargetFocus(focused bool) {
+	targets := w.targets()
+	if w.focus < len(targets) {
+		targets[w.focus].SetFocused(focused)
+	}
+}
+
+// moveFocus moves focus by delta through the targets, wrapping around
+func (w *Wizard) moveFocus(delta int) {
+	targets := w.targets()
+	w.setTargetFocus(false)
+	w.focus = (w.focus + delta + len(targets)) % len(targets)
+	w.setTargetFocus(true)
+}
+
+// SetFocused sets focus state, passing it on to the focused target
+func (w *Wizard) SetFocused(focused bool) {
+	w.BaseWidget.SetFocused(focused)
+	w.setTargetFocus(focused)
+}
+
+// Children returns the current step and the buttons
+func (w *Wizard) Children() []Widget {
+	return w.targets()
+}
+
+// Render draws the step indicator, the current step, any validation error
+// and the buttons
+func (w *Wizard) Render(buf *screen.Buffer, bounds layout.Rect) {
+	w.bounds = bounds
+	if !w.visible || bounds.IsEmpty() || bounds.Height < 3 || len(w.steps) == 0 {
+		return
+	}
+
+	step := w.steps[w.current]
+	title := fmt.Sprintf("Step %d of %d", w.current+1, len(w.steps))
+	if step.Title != "" {
+		title += ": " + step.Title
+	}
+	buf.DrawString(bounds.X, bounds.Y, bounds.Z, screen.Truncate(title, bounds.Width, true), w.titleStyle)
+
+	// The step fills the space between the indicator and the error line
+	content := bounds.Inset(2, 0, 2, 0)
+	if !content.IsEmpty() {
+		step.Widget.Render(buf.Clip(content), content)
+	}
+
+	if w.err != nil {
+		buf.DrawString(bounds.X, bounds.Y+bounds.Height-2, bounds.Z, screen.Truncate(w.err.Error(), bounds.Width, true), w.errorStyle)
+	}
+
+	// Buttons right-aligned on the last line, clipped when too narrow
+	buttonsY := bounds.Y + bounds.Height - 1
+	x := bounds.X + bounds.Width - w.next.Size().Width
+	w.next.Render(buf, layout.NewRect(x, buttonsY, bounds.Z, w.next.Size().Width, 1).Intersection(bounds))
+	if w.current > 0 {
+		x -= w.back.Size().Width + 1
+		w.back.Render(buf, layout.NewRect(x, buttonsY, bounds.Z, w.back.Size().Width, 1).Intersection(bounds))
+	}
+}
+
+// HandleEvent handles input events
+func (w *Wizard) HandleEvent(event input.Event) bool {
+	return w.HandleEventCtx(nil, event)
+}
+
+// HandleEventCtx handles input events, passing ctx to the step and buttons
+func (w *Wizard) HandleEventCtx(ctx EventContext, event input.Event) bool {
+	if len(w.steps) == 0 {
+		return false
+	}
+	targets := w.targets()
+	keyEvent, ok := event.(input.KeyEvent)
+	if !ok {
+		// Only the step handles the mouse
+		return HandleEventCtx(targets[0], ctx, event)
+	}
+	if !w.focused {
+		return false
+	}
+	if keyEvent.Key == input.KeyTab {
+		if keyEvent.IsShift() {
+			w.moveFocus(-1)
+		} else {
+			w.moveFocus(1)
+		}
+		return true
+	}
+	return HandleEventCtx(targets[w.focus], ctx, event)
+}
+
+// Size returns the preferred size
+func (w *Wizard) Size() layout.Size {
+	width, height := 0, 0
+	for _, step := range w.steps {
+		size := step.Widget.Size()
+		width = max(width, size.Width)
+		height = max(height, size.Height)
+	}
+	buttons := w.back.Size().Width + 1 + max(w.next.Size().Width, NewButton("Finish").Size().Width)
+	return layout.NewSize(max(width, buttons), height+4)
+}
+
+// MinSize returns the minimum size
+func (w *Wizard) MinSize() layout.Size {
+	return layout.NewSize(20, 5)
+}
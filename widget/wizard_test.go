@@ -0,0 +1,83 @@
+package widget
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/agiles231/gotui/input"
+)
+
+func TestWizardValidationBlocksNext(t *testing.T) {
+	account := NewForm()
+	name := account.AddRequiredTextInput("Name", "")
+	w := NewWizard().
+		AddStep("Account", account, nil).
+		AddStep("Confirm", NewText("ok"), nil)
+
+	if w.Next() {
+		t.Fatal("Next() = true with an empty required field, want false")
+	}
+	if w.Current() != 0 {
+		t.Errorf("Current() = %d, want 0", w.Current())
+	}
+	if w.Err() == nil || w.Err().Error() != "Required: Name" {
+		t.Errorf("Err() = %v, want Required: Name", w.Err())
+	}
+
+	name.SetValue("Ada")
+	if !w.Next() {
+		t.Fatal("Next() = false with the field filled in, want true")
+	}
+	if w.Current() != 1 || w.Err() != nil {
+		t.Errorf("Current(), Err() = %d, %v, want 1, nil", w.Current(), w.Err())
+	}
+}
+
+func TestWizardValidateHookBlocksFinish(t *testing.T) {
+	completed := false
+	w := NewWizard().
+		AddStep("Only", NewText("x"), func() error { return errors.New("not yet") }).
+		OnComplete(func(map[string]string) { completed = true })
+
+	// Enter on the focused Finish button runs the same validation
+	w.SetFocused(true)
+	w.HandleEvent(input.KeyEvent{Key: input.KeyTab})
+	w.HandleEvent(input.KeyEvent{Key: input.KeyEnter})
+	if completed {
+		t.Error("OnComplete called although validation failed")
+	}
+	if w.Err() == nil || w.Err().Error() != "not yet" {
+		t.Errorf("Err() = %v, want not yet", w.Err())
+	}
+}
+
+func TestWizardFinishAggregatesValues(t *testing.T) {
+	account := NewForm()
+	account.AddTextInput("Name", "").SetValue("Ada")
+	account.AddTextInput("Email", "").SetValue("ada@example.com")
+	plan := NewTextInput().SetValue("pro")
+
+	var got map[string]string
+	w := NewWizard().
+		AddStep("Account", account, nil).
+		AddStep("Plan", plan, nil).
+		OnComplete(func(values map[string]string) { got = values })
+
+	w.Next()
+	w.Back()
+	if w.Current() != 0 {
+		t.Fatalf("Current() after Back = %d, want 0", w.Current())
+	}
+	w.Next()
+	if got != nil {
+		t.Fatal("OnComplete called before the last step")
+	}
+	if !w.Next() {
+		t.Fatal("Finish returned false")
+	}
+	want := map[string]string{"Name": "Ada", "Email": "ada@example.com", "Plan": "pro"}
+	if !maps.Equal(got, want) {
+		t.Errorf("OnComplete values = %v, want %v", got, want)
+	}
+}